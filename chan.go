@@ -0,0 +1,151 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Receive checks that a value received from channel actual is equal to expected
+// (compared like DeepEqual).
+//
+// It waits for a value until t.Context() is done or [TB.Timeout] expires
+// (if set, otherwise there is no extra limit besides t.Context()).
+// Receiving from a closed channel fails the check.
+//
+// Actual must be a channel which allows receiving.
+func (t *checks) Receive(actual, expected any, msg ...any) bool {
+	t.tb.Helper()
+	v, ok, timeout := t.recv(actual, true)
+	switch {
+	case timeout != "":
+		return t.report2(timeout, expected, msg,
+			false)
+	case !ok:
+		return t.report2(note("channel is closed"), expected, msg,
+			false)
+	}
+	return t.report2(v, expected, msg,
+		elemEqual(v, expected, hasMatcher(expected)))
+}
+
+// NotReceive checks that nothing is received from channel actual.
+//
+// It waits until t.Context() is done or [TB.Timeout] expires.
+// Without [TB.Timeout] it doesn't wait at all
+// and just checks that there is nothing to receive right now.
+// Receiving from a closed channel fails the check.
+//
+// Actual must be a channel which allows receiving.
+// Value received on failure is lost for the code under test.
+func (t *checks) NotReceive(actual any, msg ...any) bool {
+	t.tb.Helper()
+	v, ok, timeout := t.recv(actual, t.timeout > 0)
+	switch {
+	case timeout != "":
+		return t.report1(actual, msg,
+			true)
+	case !ok:
+		return t.report1(note("channel is closed"), msg,
+			false)
+	}
+	return t.report1(v, msg,
+		false)
+}
+
+// Closed checks that channel actual is closed.
+//
+// It waits for channel to be closed until t.Context() is done
+// or [TB.Timeout] expires (if set).
+// Receiving a value instead fails the check
+// (so all buffered values must be received before calling Closed).
+//
+// Actual must be a channel which allows receiving.
+func (t *checks) Closed(actual any, msg ...any) bool {
+	t.tb.Helper()
+	v, ok, timeout := t.recv(actual, true)
+	switch {
+	case timeout != "":
+		return t.report1(timeout, msg,
+			false)
+	case !ok:
+		return t.report1(actual, msg,
+			true)
+	}
+	return t.report1(v, msg,
+		false)
+}
+
+// NotClosed checks that channel actual is not closed.
+//
+// It doesn't wait. Go has no way to check if channel is closed
+// without receiving from it, so:
+//   - channel with buffered values is reported as not closed
+//     (even if it was closed after sending these values);
+//   - otherwise it tries to receive from channel without blocking,
+//     and a value sent by a waiting sender (to unbuffered channel)
+//     is received and lost for the code under test.
+//
+// If a value may be sent to actual then use Receive instead:
+// it fails if channel is closed and checks received value:
+//
+//	t.Receive(ch, want)
+//
+// Actual must be a channel which allows receiving.
+func (t *checks) NotClosed(actual any, msg ...any) bool {
+	t.tb.Helper()
+	if recvChan(actual).Len() > 0 {
+		return t.report1(actual, msg,
+			true)
+	}
+	_, ok, timeout := t.recv(actual, false)
+	if timeout == "" && !ok {
+		return t.report1(note("channel is closed"), msg,
+			false)
+	}
+	return t.report1(actual, msg,
+		true)
+}
+
+// recv receives from channel ch.
+// If wait is true it waits until t.context() is done or t.timeout (if set) expires,
+// otherwise it doesn't block at all.
+// Result ok is false if channel is closed,
+// non-empty timeout means nothing was received.
+func (c *checks) recv(ch any, wait bool) (v any, ok bool, timeout note) {
+	cases := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: recvChan(ch)}}
+	if !wait {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+		chosen, recv, recvOK := reflect.Select(cases)
+		if chosen != 0 {
+			return nil, false, "nothing to receive"
+		}
+		return recv.Interface(), recvOK, ""
+	}
+
+	ctx := c.context()
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+	if c.timeout > 0 {
+		timer := time.NewTimer(c.timeout)
+		defer timer.Stop()
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)})
+	}
+
+	start := time.Now()
+	switch chosen, recv, recvOK := reflect.Select(cases); chosen {
+	case 0:
+		return recv.Interface(), recvOK, ""
+	case 1:
+		return nil, false, note(fmt.Sprintf("timed out after %v (%v)", time.Since(start).Round(time.Millisecond), ctx.Err()))
+	default:
+		return nil, false, note(fmt.Sprintf("timed out after %v", c.timeout))
+	}
+}
+
+func recvChan(ch any) reflect.Value {
+	val := reflect.ValueOf(ch)
+	if val.Kind() != reflect.Chan || val.Type().ChanDir()&reflect.RecvDir == 0 {
+		panic("actual is not a receivable channel")
+	}
+	return val
+}
@@ -0,0 +1,136 @@
+package check_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/powerman/check"
+)
+
+func TestCheckerReceive(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	short := t.Timeout(10 * time.Millisecond)
+
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	t.Receive(ch, 1)
+	todo.Receive(ch, 3)
+	short.TODO().Receive(ch, 3)
+
+	go func() { ch <- 4 }()
+	t.Receive((<-chan int)(ch), 4)
+
+	type point struct{ x, y int }
+	pch := make(chan point, 1)
+	pch <- point{1, 2}
+	t.Receive(pch, point{1, 2})
+
+	close(ch)
+	short.TODO().Receive(ch, 0)
+
+	t.PanicMatch(func() { t.Receive(42, 42) }, "actual is not a receivable channel")
+	t.PanicMatch(func() { t.Receive(make(chan<- int), 42) }, "actual is not a receivable channel")
+}
+
+func TestCheckerReceiveContext(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	tt.Cleanup(cancel)
+	t.MergeContext(ctx).TODO().Receive(make(chan int), 0)
+}
+
+func TestCheckerNotReceive(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	short := t.Timeout(10 * time.Millisecond)
+
+	ch := make(chan int, 1)
+	t.NotReceive(ch)
+	short.NotReceive(ch)
+
+	ch <- 1
+	todo.NotReceive(ch)
+	t.NotReceive(ch)
+
+	go func() {
+		time.Sleep(time.Millisecond)
+		ch <- 2
+	}()
+	t.Timeout(time.Minute).TODO().NotReceive(ch)
+
+	close(ch)
+	todo.NotReceive(ch)
+	short.TODO().NotReceive(ch)
+}
+
+func TestCheckerClosed(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	short := t.Timeout(10 * time.Millisecond)
+
+	ch := make(chan int, 1)
+	t.NotClosed(ch)
+	short.TODO().Closed(ch)
+
+	ch <- 1
+	close(ch)
+	t.NotClosed(ch) // Buffered value has to be received first.
+	todo.Closed(ch)
+	t.Closed(ch)
+	todo.NotClosed(ch)
+
+	unbuf := make(chan struct{})
+	t.NotClosed(unbuf)
+	close(unbuf)
+	t.Closed(unbuf)
+
+	t.PanicMatch(func() { t.Closed(42) }, "actual is not a receivable channel")
+	t.PanicMatch(func() { t.NotClosed(42) }, "actual is not a receivable channel")
+}
+
+// ctxTB is a fakeTB with Context, required by checkers which wait.
+type ctxTB struct {
+	*fakeTB
+
+	ctx context.Context
+}
+
+func (c *ctxTB) Context() context.Context { return c.ctx }
+
+func TestChanReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeChanReport"}
+	t := check.New(&ctxTB{fakeTB: fake, ctx: tt.Context()})
+	short := t.Timeout(10 * time.Millisecond)
+
+	closed := make(chan int)
+	close(closed)
+	short.Receive(make(chan int), 1)
+	short.Closed(make(chan int))
+	t.Receive(closed, 1)
+	t.NotClosed(closed)
+	t.NotReceive(closed)
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 5)
+	realT.Contains(fake.msgs[0], "Checker:  Receive\nExpected: (int) 1\nActual:   timed out after 10ms\n")
+	realT.Contains(fake.msgs[1], "Checker:  Closed\nActual:   timed out after 10ms\n")
+	realT.Contains(fake.msgs[2], "Checker:  Receive\nExpected: (int) 1\nActual:   channel is closed\n")
+	realT.Contains(fake.msgs[3], "Checker:  NotClosed\nActual:   channel is closed\n")
+	realT.Contains(fake.msgs[4], "Checker:  NotReceive\nActual:   channel is closed\n")
+}
+
+func TestTimeout(tt *testing.T) {
+	tt.Parallel()
+	t := check.New(tt)
+	t.PanicMatch(func() { t.Timeout(0) }, "timeout is not positive")
+	t.PanicMatch(func() { check.T(tt).Timeout(-time.Second) }, "timeout is not positive")
+}
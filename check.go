@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/cmplx"
 	"os"
 	"reflect"
 	"regexp"
@@ -19,9 +20,9 @@ import (
 
 //nolint:gochecknoglobals // Const.
 var (
-	typString  = reflect.TypeFor[string]()
-	typBytes   = reflect.TypeFor[[]byte]()
-	typFloat64 = reflect.TypeFor[float64]()
+	typString = reflect.TypeFor[string]()
+	typBytes  = reflect.TypeFor[[]byte]()
+	typError  = reflect.TypeFor[error]()
 )
 
 // C wraps [*testing.T] to make it convenient to call checkers in test.
@@ -74,6 +75,31 @@ func (t *C) MustAll() *C {
 	return &C{checks: t.withMustAll(), T: t.T}
 }
 
+// Timeout is like [TB.Timeout], but keeps working with *C and [*testing.T].
+func (t *C) Timeout(timeout time.Duration) *C {
+	return &C{checks: t.withTimeout(timeout), T: t.T}
+}
+
+// UseNumber is like [TB.UseNumber], but keeps working with *C and [*testing.T].
+func (t *C) UseNumber() *C {
+	return &C{checks: t.withUseNumber(), T: t.T}
+}
+
+// IgnoreXMLSpace is like [TB.IgnoreXMLSpace], but keeps working with *C and [*testing.T].
+func (t *C) IgnoreXMLSpace() *C {
+	return &C{checks: t.withIgnoreXMLSpace(), T: t.T}
+}
+
+// EqualNaN is like [TB.EqualNaN], but keeps working with *C and [*testing.T].
+func (t *C) EqualNaN() *C {
+	return &C{checks: t.withEqualNaN(), T: t.T}
+}
+
+// FieldErrorKeys is like [TB.FieldErrorKeys], but keeps working with *C and [*testing.T].
+func (t *C) FieldErrorKeys(keys ...FieldErrorKey) *C {
+	return &C{checks: t.withFieldErrorKeys(keys), T: t.T}
+}
+
 // Context returns the context associated with t:
 // the context merged in by the most recent [C.MergeContext] call if any,
 // otherwise the standard [*testing.T.Context]().
@@ -319,9 +345,25 @@ func hasMethod(v any, name string) bool {
 // (e.g. [time.Time], decimal.Decimal, etc.).
 //
 // Custom equal checkers registered via [RegisterEqualChecker] run first.
+//
+// Expected may contain [Matcher] at any depth: in this case values in
+// these places are checked by matcher instead
+// and on failure it shows paths of all mismatches.
 func (t *checks) DeepEqual(actual, expected any, msg ...any) bool {
 	t.tb.Helper()
-	equal, claimed := runEqualCheckers(actual, expected)
+	equal, explanation := isDeepEqual(actual, expected)
+	return t.report2Explained(actual, expected, msg,
+		equal, explanation)
+}
+
+// isDeepEqual checks actual and expected like DeepEqual and
+// returns an explanation of a difference to be shown on failure.
+func isDeepEqual(actual, expected any) (bool, func() string) {
+	if hasMatcher(expected) {
+		mismatches := matchDeep(actual, expected)
+		return len(mismatches) == 0, explainMismatches(mismatches)
+	}
+	equal, claimed, explanation := runEqualCheckers(actual, expected)
 	if !claimed {
 		if hasMethod(actual, "ProtoReflect") || hasMethod(expected, "ProtoReflect") {
 			panic("check: protobuf message detected; " +
@@ -329,7 +371,7 @@ func (t *checks) DeepEqual(actual, expected any, msg ...any) bool {
 		}
 		equal = deepequal.DeepEqual(actual, expected)
 	}
-	return t.report2(actual, expected, msg, equal)
+	return equal, func() string { return explainText("Explanation", explanation) }
 }
 
 // NotDeepEqual checks for ![deepequal.DeepEqual](actual, expected).
@@ -337,9 +379,15 @@ func (t *checks) DeepEqual(actual, expected any, msg ...any) bool {
 // (e.g. [time.Time], decimal.Decimal, etc.).
 //
 // Custom equal checkers registered via [RegisterEqualChecker] run first.
+//
+// Expected may contain [Matcher] at any depth, see DeepEqual.
 func (t *checks) NotDeepEqual(actual, expected any, msg ...any) bool {
 	t.tb.Helper()
-	equal, claimed := runEqualCheckers(actual, expected)
+	if hasMatcher(expected) {
+		return t.report1(actual, msg,
+			len(matchDeep(actual, expected)) != 0)
+	}
+	equal, claimed, _ := runEqualCheckers(actual, expected)
 	if claimed {
 		return t.report1(actual, msg, !equal)
 	}
@@ -437,8 +485,13 @@ func (t *checks) NotMatch(actual, regex any, msg ...any) bool {
 //
 // Hint: In a map it looks for a value, if you need to look for a key -
 // use HasKey instead.
+//
+// Actual may also be an iterator (iter.Seq, iter.Seq2 or a type with
+// All method returning one of them, except nil pointer): it's drained
+// and handled like a slice (for iter.Seq2 - like a map) with yielded elements.
 func (t *checks) Contains(actual, expected any, msg ...any) bool {
 	t.tb.Helper()
+	actual = drainIterValues(actual)
 	return t.report2(actual, expected, msg,
 		isContains(actual, expected))
 }
@@ -482,6 +535,7 @@ func isContains(actual, expected any) (found bool) {
 // See Contains about supported actual/expected types and check logic.
 func (t *checks) NotContains(actual, expected any, msg ...any) bool {
 	t.tb.Helper()
+	actual = drainIterValues(actual)
 	return t.report2(actual, expected, msg,
 		!isContains(actual, expected))
 }
@@ -505,8 +559,12 @@ func (t *checks) NotHasKey(actual, expected any, msg ...any) bool {
 }
 
 // Zero checks is actual is zero value of it's type.
+//
+// Iterator (iter.Seq or iter.Seq2 func value) is zero if it's nil or
+// yields no elements. A type with All method is checked as is.
 func (t *checks) Zero(actual any, msg ...any) bool {
 	t.tb.Helper()
+	actual = drainSeq(actual)
 	return t.report1(actual, msg,
 		isZero(actual))
 }
@@ -532,126 +590,164 @@ func isZero(actual any) bool {
 }
 
 // NotZero checks is actual is not zero value of it's type.
+//
+// See Zero about iterators.
 func (t *checks) NotZero(actual any, msg ...any) bool {
 	t.tb.Helper()
+	actual = drainSeq(actual)
 	return t.report1(actual, msg,
 		!isZero(actual))
 }
 
 // Len checks is len(actual) == expected.
+//
+// Actual may also be an iterator (see Contains), then it's length is
+// amount of yielded elements and on failure it shows these elements.
 func (t *checks) Len(actual any, expected int, msg ...any) bool {
 	t.tb.Helper()
-	l := reflect.ValueOf(actual).Len()
-	return t.report2(l, expected, msg,
-		l == expected)
+	drained := drainIter(actual)
+	l := reflect.ValueOf(drained).Len()
+	return t.report2Explained(l, expected, msg,
+		l == expected,
+		explainDrained(actual, drained))
 }
 
 // NotLen checks is len(actual) != expected.
+//
+// See Len about iterators.
 func (t *checks) NotLen(actual any, expected int, msg ...any) bool {
 	t.tb.Helper()
-	l := reflect.ValueOf(actual).Len()
-	return t.report2(l, expected, msg,
-		l != expected)
+	drained := drainIter(actual)
+	l := reflect.ValueOf(drained).Len()
+	return t.report2Explained(l, expected, msg,
+		l != expected,
+		explainDrained(actual, drained))
 }
 
 // Err checks is actual error is the same as expected error.
 //
-// Custom error checkers registered via [RegisterErrChecker] run first.
-// If none claims the pair the built-in comparison operates on the
-// original error found by recursively unwrapping actual with
-// [errors.Unwrap]() and [github.com/pkg/errors.Cause]()
-// (multi-error takes only the first),
-// and then compares it using Equal() method or same type and value ([deepequal.DeepEqual]),
+// It searches the whole tree of errors wrapped by actual (including actual itself)
+// using [errors.Unwrap]() (both Unwrap() error and Unwrap() []error)
+// and [github.com/pkg/errors.Cause]() for an error which is the same as expected.
+// Each error in a tree is first compared using custom error checkers
+// registered via [RegisterErrChecker] (after comparing field errors
+// by keys given to [TB.FieldErrorKeys], if any) and, if none claims the pair,
+// by using Equal() method or same type and value ([deepequal.DeepEqual]),
 // so they may be different instances, but must have the same type and value.
 //
-// If both of these fail the comparison falls back to [errors.Is]()
-// on the original actual (not the unwrapped one).
+// If this fails the comparison falls back to [errors.Is]()
+// on the original actual.
 //
 // Checking for nil is okay, but using Nil(actual) instead is more clean.
+//
+// On failure it shows actual as a tree of wrapped errors
+// with errors of same type as expected marked.
 func (t *checks) Err(actual, expected error, msg ...any) bool {
 	t.tb.Helper()
-	equal, claimed := runCheckers(actual, expected)
-	if !claimed {
-		actual2 := unwrapErr(actual)
-		if hasMethod(actual2, "GRPCStatus") || hasMethod(expected, "GRPCStatus") {
-			panic("check: gRPC status error detected; " +
-				"import github.com/powerman/checkgrpc to compare gRPC status errors")
+	ok := t.isErr(actual, expected)
+	return t.report2Explained(t.errArg(ok, actual, markSameType(expected)), expected, msg,
+		ok, func() string { return t.explainErrCheckers(actual, expected) })
+}
+
+func (c *checks) isErr(actual, expected error) bool {
+	for _, err := range errTree(actual) {
+		if c.isErrValue(err, expected) {
+			return true
 		}
-		equal = reflect.TypeOf(actual2) == reflect.TypeOf(expected) &&
-			deepequal.DeepEqual(actual2, expected)
 	}
-	if !equal {
-		equal = errors.Is(actual, expected)
+	return errors.Is(actual, expected)
+}
+
+// isErrValue checks is err (without unwrapping) is the same as expected
+// using custom error checkers or same type and value.
+func (c *checks) isErrValue(err, expected error) bool {
+	if equal, claimed, _ := c.runErrCheckers(err, expected); claimed {
+		return equal
+	}
+	if hasMethod(err, "GRPCStatus") || hasMethod(expected, "GRPCStatus") {
+		panic("check: gRPC status error detected; " +
+			"import github.com/powerman/checkgrpc to compare gRPC status errors")
 	}
-	return t.report2(actual, expected, msg, equal)
+	return reflect.TypeOf(err) == reflect.TypeOf(expected) && deepequal.DeepEqual(err, expected)
+}
+
+// explainErrCheckers returns explanations given by custom error checkers
+// for errors in actual's tree which they claimed as not the same as expected.
+// Same explanation given for several errors (e.g. for a wrapper and
+// an error it wraps) is shown once.
+func (c *checks) explainErrCheckers(actual, expected error) string {
+	var buf strings.Builder
+	seen := make(map[string]bool)
+	for _, err := range errTree(actual) {
+		_, claimed, explanation := c.runErrCheckers(err, expected)
+		if claimed && explanation != "" && !seen[explanation] {
+			seen[explanation] = true
+			fmt.Fprintf(&buf, "%T: %s\n", err, strings.ReplaceAll(explanation, "\n", "\n  "))
+		}
+	}
+	return explainText("Explanation", buf.String())
 }
 
-// cause replicates github.com/pkg/errors.Cause using duck typing,
-// to support that (archived) package without depending on it.
-func cause(err error) error {
-	for err != nil {
-		c, ok := err.(interface{ Cause() error })
-		if !ok {
-			break
+// runErrCheckers is like runCheckers, but first compares field errors
+// by keys given to FieldErrorKeys (if any).
+func (c *checks) runErrCheckers(actual, expected error) (equal, ok bool, explanation string) {
+	if c.fieldErrorKeys != nil {
+		if equal, ok, explanation = compareFieldErrors(actual, expected, c.fieldErrorKeys); ok {
+			return equal, ok, explanation
 		}
-		err = c.Cause()
 	}
-	return err
+	return runCheckers(actual, expected)
+}
+
+// unwrapOnce returns errors directly wrapped by err
+// (using Cause() if err has it, or else Unwrap()).
+func unwrapOnce(err error) []error {
+	switch wrapped := err.(type) { //nolint:errorlint // False positive.
+	case interface{ Cause() error }:
+		return []error{wrapped.Cause()}
+	case interface{ Unwrap() error }:
+		return []error{wrapped.Unwrap()}
+	case interface{ Unwrap() []error }:
+		return wrapped.Unwrap()
+	}
+	return nil
 }
 
-func unwrapErr(err error) (actual error) {
+// errTree returns err and all errors wrapped by it (recursively),
+// in pre-order.
+func errTree(err error) (errs []error) {
 	defer func() { _ = recover() }()
-	actual = err
-	for {
-		actual = cause(actual)
-		var unwrapped error
-		switch wrapped := actual.(type) { //nolint:errorlint // False positive.
-		case interface{ Unwrap() error }:
-			unwrapped = wrapped.Unwrap()
-		case interface{ Unwrap() []error }:
-			unwrappeds := wrapped.Unwrap()
-			if len(unwrappeds) > 0 {
-				unwrapped = unwrappeds[0]
+	var walk func(error)
+	walk = func(err error) {
+		errs = append(errs, err)
+		for _, wrapped := range unwrapOnce(err) {
+			if wrapped != nil {
+				walk(wrapped)
 			}
 		}
-		if unwrapped == nil {
-			break
-		}
-		actual = unwrapped
 	}
-	return actual
+	walk(err)
+	return errs
 }
 
 // NotErr checks is actual error is not the same as expected error.
 //
-// It tries to recursively unwrap actual before checking using
-// [errors.Unwrap]() and [github.com/pkg/errors.Cause]().
-// In case of multi-error (Unwrap() []error) it use only first error.
+// See Err about check logic: none of errors in actual's tree must be
+// the same as expected and ![errors.Is]() must be true.
 //
 // They must have either different types or values (or one should be nil).
 // Different instances with same type and value will be considered the
 // same error, and so is both nil.
 //
-// Finally it'll use ![errors.Is]().
+// On failure it shows actual as a tree of wrapped errors
+// with errors matching expected marked.
 func (t *checks) NotErr(actual, expected error, msg ...any) bool {
 	t.tb.Helper()
-	equal, claimed := runCheckers(actual, expected)
-	var notEqual bool
-	if claimed {
-		notEqual = !equal
-	} else {
-		actual2 := unwrapErr(actual)
-		if hasMethod(actual2, "GRPCStatus") || hasMethod(expected, "GRPCStatus") {
-			panic("check: gRPC status error detected; " +
-				"import github.com/powerman/checkgrpc to compare gRPC status errors")
-		}
-		notEqual = reflect.TypeOf(actual2) != reflect.TypeOf(expected) ||
-			!deepequal.DeepEqual(actual2, expected)
-	}
-	if notEqual {
-		notEqual = !errors.Is(actual, expected)
-	}
-	return t.report1(actual, msg, notEqual)
+	ok := !t.isErr(actual, expected)
+	return t.report1(t.errArg(ok, actual, markMatched(func(err error) bool {
+		return t.isErrValue(err, expected) || isErrIdentical(err, expected)
+	})), msg,
+		ok)
 }
 
 // ErrIs checks for [errors.Is]().
@@ -661,20 +757,30 @@ func (t *checks) NotErr(actual, expected error, msg ...any) bool {
 //
 // See Err for value-equality checks. ErrIs is preferred when you want
 // the standard Go unwrapping semantics without value comparison.
+//
+// On failure it shows actual as a tree of wrapped errors
+// with errors of same type as expected marked.
 func (t *checks) ErrIs(actual, expected error, msg ...any) bool {
 	t.tb.Helper()
-	return t.report2(actual, expected, msg,
-		errors.Is(actual, expected))
+	ok := errors.Is(actual, expected)
+	return t.report2(t.errArg(ok, actual, markSameType(expected)), expected, msg,
+		ok)
 }
 
 // NotErrIs checks for ![errors.Is]().
 //
 // See ErrIs for details. Note that nil is not matched by [errors.Is]
 // against any non-nil error, so NotErrIs(nil, [io.EOF]) passes.
+//
+// On failure it shows actual as a tree of wrapped errors
+// with errors matching expected marked.
 func (t *checks) NotErrIs(actual, expected error, msg ...any) bool {
 	t.tb.Helper()
-	return t.report1(actual, msg,
-		!errors.Is(actual, expected))
+	ok := !errors.Is(actual, expected)
+	return t.report1(t.errArg(ok, actual, markMatched(func(err error) bool {
+		return isErrIdentical(err, expected)
+	})), msg,
+		ok)
 }
 
 // ErrAs checks for [errors.As].
@@ -682,10 +788,13 @@ func (t *checks) NotErrIs(actual, expected error, msg ...any) bool {
 // target must be a non-nil pointer to an error type or to an interface,
 // as required by [errors.As]. On success target is filled
 // with the matched error value. See [errors.As] documentation for details.
+//
+// On failure it shows actual as a tree of wrapped errors.
 func (t *checks) ErrAs(actual error, target any, msg ...any) bool {
 	t.tb.Helper()
-	return t.report2(actual, target, msg,
-		errors.As(actual, target))
+	ok := errors.As(actual, target)
+	return t.report2(t.errArg(ok, actual, markNone), target, msg,
+		ok)
 }
 
 // NotErrAs checks for ![errors.As].
@@ -694,10 +803,16 @@ func (t *checks) ErrAs(actual error, target any, msg ...any) bool {
 // as required by [errors.As]. Note that [errors.As] may still fill target
 // with a matched error even when this check returns true,
 // because [errors.As] is always called regardless of the negated result.
+//
+// On failure it shows actual as a tree of wrapped errors
+// with errors matching target marked.
 func (t *checks) NotErrAs(actual error, target any, msg ...any) bool {
 	t.tb.Helper()
-	return t.report1(actual, msg,
-		!errors.As(actual, target))
+	ok := !errors.As(actual, target)
+	return t.report1(t.errArg(ok, actual, markMatched(func(err error) bool {
+		return isErrAssignable(err, target)
+	})), msg,
+		ok)
 }
 
 // Panic checks is actual() panics.
@@ -718,16 +833,21 @@ func (t *checks) Panic(actual func(), msg ...any) bool {
 // NotPanic checks is actual() don't panics.
 //
 // It is able to detect panic(nil)… but you should try to avoid using this.
+//
+// On failure it shows a panic value and a goroutine stack captured
+// at recover time.
 func (t *checks) NotPanic(actual func(), msg ...any) bool {
 	t.tb.Helper()
-	didPanic := true
-	func() {
-		defer func() { _ = recover() }()
-		actual()
-		didPanic = false
-	}()
-	return t.report0(msg,
-		!didPanic)
+	p := catchPanic(actual)
+	if !p.didPanic {
+		return t.report0(msg,
+			true)
+	}
+	return t.reportExplained(false, msg,
+		callerFuncName(0),
+		[]string{"Panic"},
+		[]any{p.value},
+		p.explainStack)
 }
 
 // PanicMatch checks is actual() panics and panic text match regex.
@@ -988,19 +1108,25 @@ func (t *checks) NotBetweenOrEqual(actual, minimum, maximum any, msg ...any) boo
 //   - signed integers
 //   - unsigned integers
 //   - floats
+//   - complex numbers (in this case delta must be a float and it's
+//     compared with a distance between actual and expected in the complex plane)
 //   - [time.Time] (in this case delta must be [time.Duration])
+//
+// NaN isn't close to anything (use [TB.EqualNaN] to make NaN close to NaN),
+// +Inf and -Inf are close only to themselves.
+// Nothing is close with a negative or NaN delta.
 func (t *checks) InDelta(actual, expected, delta any, msg ...any) bool {
 	t.tb.Helper()
 	return t.report3(actual, expected, delta, msg,
-		isInDelta(actual, expected, delta))
+		isInDelta(actual, expected, delta, t.equalNaN))
 }
 
-func isInDelta(actual, expected, delta any) bool {
+func isInDelta(actual, expected, delta any, equalNaN bool) bool { //nolint:gocyclo,cyclop // By design.
 	switch v, e, d := reflect.ValueOf(actual), reflect.ValueOf(expected), reflect.ValueOf(delta); v.Kind() { //nolint:exhaustive // Covered by default case.
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		dd := d.Int()
 		if dd < 0 {
-			return false // negative delta: preserve old always-false behavior
+			return false // Invalid delta, nothing is in delta.
 		}
 		a, e2 := v.Int(), e.Int()
 		var diff uint64 // |a-e2| computed without overflow via two's complement
@@ -1020,8 +1146,21 @@ func isInDelta(actual, expected, delta any) bool {
 		}
 		return diff <= dd
 	case reflect.Float32, reflect.Float64:
-		minimum, maximum := e.Float()-d.Float(), e.Float()+d.Float()
-		return minimum <= v.Float() && v.Float() <= maximum
+		if d.Float() < 0 || math.IsNaN(d.Float()) {
+			return false // Invalid delta, nothing is in delta.
+		}
+		return isTolerated(complex(v.Float(), 0), complex(e.Float(), 0), equalNaN, func() bool {
+			minimum, maximum := e.Float()-d.Float(), e.Float()+d.Float()
+			return minimum <= v.Float() && v.Float() <= maximum
+		})
+	case reflect.Complex64, reflect.Complex128:
+		a, e2, dd := v.Complex(), e.Complex(), d.Float()
+		if dd < 0 || math.IsNaN(dd) {
+			return false // Invalid delta, nothing is in delta.
+		}
+		return isTolerated(a, e2, equalNaN, func() bool {
+			return cmplx.Abs(a-e2) <= dd
+		})
 	default:
 		if actualTime, ok := actual.(time.Time); ok {
 			expectedTime := expected.(time.Time) //nolint:forcetypeassert // Want panic.
@@ -1037,15 +1176,11 @@ func isInDelta(actual, expected, delta any) bool {
 
 // NotInDelta checks for actual < expected-delta or expected+delta < actual.
 //
-// All three actual, expected and delta must be either:
-//   - signed integers
-//   - unsigned integers
-//   - floats
-//   - [time.Time] (in this case delta must be [time.Duration])
+// See InDelta about supported actual/expected/delta types and check logic.
 func (t *checks) NotInDelta(actual, expected, delta any, msg ...any) bool {
 	t.tb.Helper()
 	return t.report3(actual, expected, delta, msg,
-		!isInDelta(actual, expected, delta))
+		!isInDelta(actual, expected, delta, t.equalNaN))
 }
 
 // InSMAPE checks that actual and expected have a symmetric mean absolute
@@ -1055,6 +1190,7 @@ func (t *checks) NotInDelta(actual, expected, delta any, msg ...any) bool {
 //   - signed integers
 //   - unsigned integers
 //   - floats
+//   - complex numbers (absolute values are used in formula)
 //
 // Allowed smape values are: 0.0 < smape < 100.0.
 //
@@ -1069,22 +1205,23 @@ func (t *checks) NotInDelta(actual, expected, delta any, msg ...any) bool {
 //   - 99.0+ when actual and expected differs in 200+ times
 //   - 100.0 when only one of actual or expected is 0 or one of them is
 //     positive while another is negative
+//
+// NaN isn't close to anything (use [TB.EqualNaN] to make NaN close to NaN),
+// +Inf and -Inf are close only to themselves.
 func (t *checks) InSMAPE(actual, expected any, smape float64, msg ...any) bool {
 	t.tb.Helper()
 	return t.report3(actual, expected, smape, msg,
-		isInSMAPE(actual, expected, smape))
+		isInSMAPE(actual, expected, smape, t.equalNaN))
 }
 
-func isInSMAPE(actual, expected any, smape float64) bool {
+func isInSMAPE(actual, expected any, smape float64, equalNaN bool) bool {
 	if !(0 < smape && smape < 100) {
 		panic("smape is not in allowed range: 0 < smape < 100")
 	}
-	a := reflect.ValueOf(actual).Convert(typFloat64).Float()
-	e := reflect.ValueOf(expected).Convert(typFloat64).Float()
-	if a == 0 && e == 0 {
-		return true // avoid division by zero in legal use case
-	}
-	return 100*math.Abs(e-a)/(math.Abs(e)+math.Abs(a)) < smape
+	a, e := complexOf(actual, "actual"), complexOf(expected, "expected")
+	return isTolerated(a, e, equalNaN, func() bool { // Equal also avoids division by zero in legal use case.
+		return 100*cmplx.Abs(e-a)/(cmplx.Abs(e)+cmplx.Abs(a)) < smape
+	})
 }
 
 // NotInSMAPE checks that actual and expected have a symmetric mean
@@ -1095,7 +1232,7 @@ func isInSMAPE(actual, expected any, smape float64) bool {
 func (t *checks) NotInSMAPE(actual, expected any, smape float64, msg ...any) bool {
 	t.tb.Helper()
 	return t.report3(actual, expected, smape, msg,
-		!isInSMAPE(actual, expected, smape))
+		!isInSMAPE(actual, expected, smape, t.equalNaN))
 }
 
 // HasPrefix checks for [strings.HasPrefix](actual, expected).
@@ -1192,28 +1329,56 @@ func (t *checks) NotHasSuffix(actual, expected any, msg ...any) bool {
 //
 // In case any of actual or expected is nil or empty or (for string or
 // []byte) is invalid JSON - check will fail.
+// On failure it shows actual and expected (if they're valid JSON)
+// indented with sorted keys, their diff and JSON paths of all differences.
+//
+// Numbers are compared as float64 unless [TB.UseNumber] is used.
 func (t *checks) JSONEqual(actual, expected any, msg ...any) bool {
 	t.tb.Helper()
-	ok := isJSONEqual(actual, expected)
+	jsonActual, jsonExpected := jsonify(actual, t.useNumber), jsonify(expected, t.useNumber)
+	ok := len(jsonActual) != 0 && len(jsonExpected) != 0 &&
+		bytes.Equal(jsonActual, jsonExpected)
+	var differences []string
 	if !ok {
-		if buf := jsonify(actual); len(buf) != 0 {
-			actual = buf
+		docActual, okActual := jsonDecode(actual, t.useNumber)
+		docExpected, okExpected := jsonDecode(expected, t.useNumber)
+		if okActual && okExpected {
+			differences = jsonDiff(docActual, docExpected, "$")
 		}
-		if buf := jsonify(expected); len(buf) != 0 {
-			expected = buf
+		if okActual {
+			actual = jsonRaw(docActual)
+		}
+		if okExpected {
+			expected = jsonRaw(docExpected)
 		}
 	}
-	return t.report2(actual, expected, msg,
-		ok)
+	return t.reportExplained(ok, msg,
+		callerFuncName(0),
+		[]string{nameActual, nameExpected},
+		[]any{actual, expected},
+		func() string {
+			if len(differences) == 0 {
+				return ""
+			}
+			return "Differences:\n  " + strings.Join(differences, "\n  ") + "\n"
+		})
 }
 
-func isJSONEqual(actual, expected any) bool {
-	jsonActual, jsonExpected := jsonify(actual), jsonify(expected)
-	return len(jsonActual) != 0 && len(jsonExpected) != 0 &&
-		bytes.Equal(jsonActual, jsonExpected)
+// jsonify returns normalized arg, or arg as is for [json.RawMessage].
+func jsonify(arg any, useNumber bool) json.RawMessage {
+	switch arg.(type) {
+	case nil, json.RawMessage, *json.RawMessage:
+		return jsonBytes(arg)
+	}
+	v, ok := jsonDecode(arg, useNumber)
+	if !ok {
+		return nil
+	}
+	return jsonRaw(v)
 }
 
-func jsonify(arg any) json.RawMessage {
+// jsonBytes returns arg (supported by JSONEqual) as is, converted to []byte.
+func jsonBytes(arg any) []byte {
 	switch v := (arg).(type) {
 	case nil:
 		return nil
@@ -1225,18 +1390,7 @@ func jsonify(arg any) json.RawMessage {
 		}
 		return *v
 	}
-	buf := reflect.ValueOf(arg).Convert(typBytes).Interface().([]byte) //nolint:forcetypeassert // Want panic.
-
-	var v any
-	err := json.Unmarshal(buf, &v)
-	if err != nil {
-		return nil
-	}
-	buf, err = json.Marshal(v)
-	if err != nil {
-		return nil
-	}
-	return buf
+	return reflect.ValueOf(arg).Convert(typBytes).Interface().([]byte) //nolint:forcetypeassert // Want panic.
 }
 
 // HasType checks is actual has same type as expected.
@@ -1289,10 +1443,21 @@ func (t *checks) NotImplements(actual, expected any, msg ...any) bool {
 // Both actual and expected must be slices or arrays.
 // Elements need not be sortable and are compared like DeepEqual.
 // Nil and empty slices are equal (like BytesEqual, unlike DeepEqual).
+//
+// Both actual and expected may also be iterators (see Contains), they're
+// drained into slices first: iter.Seq[T] into []T and iter.Seq2[K,V] into
+// []struct{Key K; Value V}, so iter.Seq2 should be compared with another
+// iter.Seq2 (e.g. [maps.All] of expected map).
+//
+// On failure it shows elements of expected missing from actual
+// and elements of actual unexpected in expected.
 func (t *checks) SortEqual(actual, expected any, msg ...any) bool {
 	t.tb.Helper()
-	return t.report2(actual, expected, msg,
-		isSortEqual(actual, expected))
+	actual, expected = drainIter(actual), drainIter(expected)
+	matchers := hasMatcher(expected)
+	return t.report2Explained(actual, expected, msg,
+		isSortEqual(actual, expected, matchers),
+		explainMultiset(actual, expected, matchers, true))
 }
 
 // NotSortEqual checks !SortEqual(actual, expected).
@@ -1300,11 +1465,12 @@ func (t *checks) SortEqual(actual, expected any, msg ...any) bool {
 // See SortEqual about supported actual/expected types and check logic.
 func (t *checks) NotSortEqual(actual, expected any, msg ...any) bool {
 	t.tb.Helper()
+	actual, expected = drainIter(actual), drainIter(expected)
 	return t.report1(actual, msg,
-		!isSortEqual(actual, expected))
+		!isSortEqual(actual, expected, hasMatcher(expected)))
 }
 
-func isSortEqual(actual, expected any) bool {
+func isSortEqual(actual, expected any, matchers bool) bool {
 	va, ve := reflect.ValueOf(actual), reflect.ValueOf(expected)
 	if va.Kind() != reflect.Slice && va.Kind() != reflect.Array {
 		panic("actual is not a slice or array")
@@ -1315,26 +1481,67 @@ func isSortEqual(actual, expected any) bool {
 	if va.Len() != ve.Len() {
 		return false
 	}
-	return isMultisetIn(va, ve)
+	return isMultisetIn(ve, va, matchers)
 }
 
-// isMultisetIn reports whether every element of small has a distinct,
-// not-yet-used matching element in big, i.e. small is a multiset-subset of big.
-func isMultisetIn(small, big reflect.Value) bool {
-	used := make([]bool, big.Len())
-	for i := range small.Len() {
+// isMultisetIn reports whether every element of expected has a distinct,
+// not-yet-used matching element in actual, i.e. expected is a multiset-subset of actual.
+// Matchers is true if expected contains [Matcher].
+func isMultisetIn(expected, actual reflect.Value, matchers bool) bool {
+	missing, _ := multisetDiff(expected, actual, matchers)
+	return len(missing) == 0
+}
+
+// multisetDiff returns indices of elements of expected which has no distinct,
+// not-yet-used matching element in actual, and indices of unused elements of actual.
+// Matchers is true if expected contains [Matcher].
+func multisetDiff(expected, actual reflect.Value, matchers bool) (missing, unused []int) {
+	used := make([]bool, actual.Len())
+	for i := range expected.Len() {
 		found := false
-		for j := range big.Len() {
-			if !used[j] && elemEqual(small.Index(i).Interface(), big.Index(j).Interface()) {
+		for j := range actual.Len() {
+			if !used[j] && elemEqual(actual.Index(j).Interface(), expected.Index(i).Interface(), matchers) {
 				used[j], found = true, true
 				break
 			}
 		}
 		if !found {
-			return false
+			missing = append(missing, i)
 		}
 	}
-	return true
+	for j := range used {
+		if !used[j] {
+			unused = append(unused, j)
+		}
+	}
+	return missing, unused
+}
+
+// explainMultiset lists elements of expected missing from actual
+// and (if withUnexpected) elements of actual unexpected in expected.
+func explainMultiset(actual, expected any, matchers, withUnexpected bool) func() string {
+	return func() string {
+		va, ve := reflect.ValueOf(actual), reflect.ValueOf(expected)
+		missing, unexpected := multisetDiff(ve, va, matchers)
+		var buf strings.Builder
+		if len(missing) > 0 {
+			buf.WriteString(explainIndices("Missing from actual", ve, missing))
+		}
+		if len(unexpected) > 0 && withUnexpected {
+			buf.WriteString(explainIndices("Unexpected in actual", va, unexpected))
+		}
+		return buf.String()
+	}
+}
+
+func explainIndices(title string, val reflect.Value, indices []int) string {
+	name := make([]string, len(indices))
+	values := make([]any, len(indices))
+	for k, i := range indices {
+		name[k] = fmt.Sprintf("[%d]", i)
+		values[k] = val.Index(i).Interface()
+	}
+	return explain(title, name, values)
 }
 
 // Subset checks that actual contains all elements of expected:
@@ -1344,12 +1551,24 @@ func isMultisetIn(small, big reflect.Value) bool {
 // actual and expected must both be slices/arrays or both be maps.
 // Elements/values are compared like DeepEqual.
 // An empty/nil expected is a subset of anything of the same kind.
+// Iterators are drained into slices like in SortEqual.
+//
+// On failure it shows elements of expected missing from actual,
+// or for maps - missing keys and keys with different values (with diff).
 //
 // Note: unlike testify's Subset, duplicates are counted, so [1,1] is not a subset of [1].
 func (t *checks) Subset(actual, expected any, msg ...any) bool {
 	t.tb.Helper()
-	return t.report2(actual, expected, msg,
-		isSubset(actual, expected))
+	actual, expected = drainIter(actual), drainIter(expected)
+	matchers := hasMatcher(expected)
+	ok := isSubset(actual, expected, matchers)
+	explanation := explainMultiset(actual, expected, matchers, false)
+	if reflect.ValueOf(expected).Kind() == reflect.Map {
+		explanation = explainMapSubset(actual, expected, matchers)
+	}
+	return t.report2Explained(actual, expected, msg,
+		ok,
+		explanation)
 }
 
 // NotSubset checks !Subset(actual, expected).
@@ -1357,37 +1576,77 @@ func (t *checks) Subset(actual, expected any, msg ...any) bool {
 // See Subset about supported actual/expected types and check logic.
 func (t *checks) NotSubset(actual, expected any, msg ...any) bool {
 	t.tb.Helper()
+	actual, expected = drainIter(actual), drainIter(expected)
 	return t.report1(actual, msg,
-		!isSubset(actual, expected))
+		!isSubset(actual, expected, hasMatcher(expected)))
 }
 
-func isSubset(actual, expected any) bool {
+func isSubset(actual, expected any, matchers bool) bool {
 	va, ve := reflect.ValueOf(actual), reflect.ValueOf(expected)
 	switch ve.Kind() { //nolint:exhaustive // Covered by default case.
 	case reflect.Map:
 		if va.Kind() != reflect.Map {
 			panic("actual is not a map")
 		}
-		return isMapSubset(va, ve)
+		return isMapSubset(va, ve, matchers)
 	case reflect.Slice, reflect.Array:
 		if va.Kind() != reflect.Slice && va.Kind() != reflect.Array {
 			panic("actual is not a slice or array")
 		}
-		return isMultisetIn(ve, va)
+		return isMultisetIn(ve, va, matchers)
 	default:
 		panic("expected is not a slice, array or map")
 	}
 }
 
-func isMapSubset(actual, expected reflect.Value) bool {
-	iter := expected.MapRange()
-	for iter.Next() {
-		v := actual.MapIndex(iter.Key())
-		if !v.IsValid() || !elemEqual(v.Interface(), iter.Value().Interface()) {
-			return false
+func isMapSubset(actual, expected reflect.Value, matchers bool) bool {
+	missing, different := mapSubsetDiff(actual, expected, matchers)
+	return len(missing) == 0 && len(different) == 0
+}
+
+// mapSubsetDiff returns keys of expected missing in actual
+// and keys with different values in actual and expected.
+// Matchers is true if expected contains [Matcher].
+func mapSubsetDiff(actual, expected reflect.Value, matchers bool) (missing, different []reflect.Value) {
+	for _, k := range sortedMapKeys(expected) {
+		v := actual.MapIndex(k)
+		switch {
+		case !v.IsValid():
+			missing = append(missing, k)
+		case !elemEqual(v.Interface(), expected.MapIndex(k).Interface(), matchers):
+			different = append(different, k)
 		}
 	}
-	return true
+	return missing, different
+}
+
+func explainMapSubset(actual, expected any, matchers bool) func() string {
+	return func() string {
+		va, ve := reflect.ValueOf(actual), reflect.ValueOf(expected)
+		missing, different := mapSubsetDiff(va, ve, matchers)
+		var buf strings.Builder
+		if len(missing) > 0 {
+			name := make([]string, len(missing))
+			values := make([]any, len(missing))
+			for i, k := range missing {
+				name[i] = matcherArg(k.Interface())
+				values[i] = ve.MapIndex(k).Interface()
+			}
+			buf.WriteString(explain("Missing keys", name, values))
+		}
+		if len(different) > 0 {
+			name := make([]string, len(different))
+			actualValues := make([]any, len(different))
+			expectedValues := make([]any, len(different))
+			for i, k := range different {
+				name[i] = matcherArg(k.Interface())
+				actualValues[i] = va.MapIndex(k).Interface()
+				expectedValues[i] = ve.MapIndex(k).Interface()
+			}
+			buf.WriteString(explainDiffs("Different values", name, actualValues, expectedValues))
+		}
+		return buf.String()
+	}
 }
 
 // FileExists checks that path exists and is not a directory.
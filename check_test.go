@@ -1019,6 +1019,26 @@ func TestCheckerSubset(tt *testing.T) {
 	t.PanicMatch(func() { t.Subset([]int{1}, nil) }, "expected is not a slice, array or map")
 }
 
+func TestCheckerSortEqualSubsetExplanation(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeSortEqualSubsetExplanation"}
+	t := check.New(fake)
+
+	t.SortEqual([]int{1, 2, 3, 4}, []int{4, 3, 5, 1, 1})
+	t.Subset([]int{1, 2, 3, 4}, []int{4, 5})
+	t.Subset(map[string]any{"a": 1, "b": []int{1, 2}}, map[string]any{"a": 2, "b": []int{1, 3}, "c": 3})
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 3)
+	realT.Contains(fake.msgs[0], "Missing from actual:\n  [2]: (int) 5\n  [4]: (int) 1\n")
+	realT.Contains(fake.msgs[0], "Unexpected in actual:\n  [1]: (int) 2\n")
+	realT.Contains(fake.msgs[1], "Missing from actual:\n  [1]: (int) 5\n")
+	realT.NotContains(fake.msgs[1], "Unexpected in actual")
+	realT.Contains(fake.msgs[2], "Missing keys:\n  \"c\": (int) 3\n")
+	realT.Contains(fake.msgs[2], "Different values:\n  \"a\":\n    Expected: (int) 2\n    Actual:   (int) 1\n  \"b\":\n")
+	realT.Contains(fake.msgs[2], "    -  (int) 3\n    +  (int) 2\n")
+}
+
 // TestElemEqualUsesRegisteredChecker verifies SortEqual/Subset element comparison
 // consults the EqualChecker registry (see RegisterEqualChecker) before falling back
 // to DeepEqual, same as DeepEqual/NotDeepEqual do.
@@ -1825,6 +1845,36 @@ func TestJSONEqual(tt *testing.T) {
 			t.JSONEqual(actual, expected)
 		}
 	}
+	todo.JSONEqual(`{"a":1,"b":[2,3],"c":{}}`, `{"a":"1","b":[2],"d":{}}`)
+	todo.JSONEqual(json.RawMessage(`{"b":[2],"a":1}`), `{"b":[2],"a":1}`)
+}
+
+func TestUseNumber(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	const big1, big2 = `{"id":12345678901234567891}`, `{"id":12345678901234567890}`
+	t.JSONEqual(big1, big2)
+	todo.UseNumber().JSONEqual(big1, big2)
+	t.UseNumber().JSONEqual(big1, big1)
+	t.UseNumber().JSONEqual(`[1.0,1e2,0.10,-0.5e-1]`, `[1,100,0.1,-0.05]`)
+	t.UseNumber().JSONEqual(`[12345678901234567890.0,1.000000000000000000010]`,
+		`[12345678901234567890,1.00000000000000000001]`)
+	todo.UseNumber().JSONEqual(`[1.00000000000000000001]`, `[1.00000000000000000002]`)
+	todo.UseNumber().JSONEqual(`1 2`, `1`)
+	todo.UseNumber().JSONEqual(`{`, `{`)
+
+	t.JSONPath(big1, "$.id", uint64(12345678901234567890))
+	todo.UseNumber().JSONPath(big1, "$.id", uint64(12345678901234567890))
+	t.UseNumber().JSONPath(big1, "$.id", uint64(12345678901234567891))
+	t.UseNumber().JSONPath(big1, "$.id", json.Number("12345678901234567891.0"))
+	t.JSONSubset(big1, big2)
+	todo.UseNumber().JSONSubset(big1, big2)
+	t.UseNumber().JSONSubsetUnordered(`[`+big2+`,`+big1+`]`, `[`+big1+`]`)
+
+	t.UseNumber().TODO().Equal(1, 2)
+	t.TODO().UseNumber().Equal(1, 2)
 }
 
 func TestHasType(tt *testing.T) {
@@ -1953,7 +2003,10 @@ func TestCheckers(t *testing.T) {
 			{true, false, false, fmt.Errorf("wrapped[]: %w %w", io.EOF, &myError{"EOF"}), io.EOF},
 			{true, false, false, fmt.Errorf("wrapped[]: %w %w", &myError{"EOF"}, io.EOF), io.EOF},
 			{true, false, false, fmt.Errorf("wrapped[]: %w %w", &myError{"EOF"}, io.EOF), &myError{"EOF"}},
-			{false, false, false, fmt.Errorf("wrapped[]: %w %w", io.EOF, &myError{"EOF"}), &myError{"EOF"}},
+			{true, false, false, fmt.Errorf("wrapped[]: %w %w", io.EOF, &myError{"EOF"}), &myError{"EOF"}},
+			{true, false, false, errors.Join(io.EOF, fmt.Errorf("wrapped: %w", &myError{"EOF"})), &myError{"EOF"}},
+			{true, false, false, fmt.Errorf("wrapped: %w", errors.Join(io.EOF, &myError{"EOF"})), &myError{"EOF"}},
+			{false, false, false, errors.Join(io.EOF, &myError{"EOF"}), &myError{"EOF2"}},
 			{true, false, false, fmt.Errorf("wrapped2: %w", fmt.Errorf("wrapped: %w", io.EOF)), io.EOF},
 			{true, false, false, fmt.Errorf("wrapped2: %w", pkgerrorsWrap(io.EOF, "wrapped")), io.EOF},
 			{true, false, false, pkgerrorsWrap(fmt.Errorf("wrapped: %w", io.EOF), "wrapped2"), io.EOF},
@@ -2291,7 +2344,7 @@ func TestResetErrCheckers(tt *testing.T) {
 		t.Err(always, io.EOF)
 	})
 
-	// Reset removes the custom checker AND the default CheckFieldError.
+	// Reset removes the custom checker AND the default ExplainFieldError.
 	check.ResetErrCheckers()
 
 	// After reset, Err uses built-in logic: different types → not equal.
@@ -2302,8 +2355,8 @@ func TestResetErrCheckers(tt *testing.T) {
 		t.NotErr(always, io.EOF)
 	})
 
-	// Re-register CheckFieldError to restore default state.
-	check.RegisterErrChecker(check.CheckFieldError)
+	// Re-register ExplainFieldError to restore default state.
+	check.RegisterErrExplainer(check.ExplainFieldError)
 }
 
 // Fake types for probing-panic tests — they have ProtoReflect/GRPCStatus
@@ -0,0 +1,344 @@
+// Package checktest provides a fake [testing.TB] to unit-test custom
+// checkers (like functions for [check.TB.Should]) and test helpers
+// which use [check.TB], without failing the enclosing test.
+//
+//	func TestBePositive(tt *testing.T) {
+//		t := check.Must(tt)
+//		fake := checktest.ExpectFailure(tt, func(t *check.TB) {
+//			t.Should(bePositive, -1)
+//		})
+//		t.Contains(fake.Errors()[0], "Checker:  Should bePositive")
+//	}
+package checktest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+var ansiRE = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// TB is a fake [testing.TB] which records everything reported to it
+// instead of reporting it to a real test.
+//
+// It must be used by calling [TB.Run].
+// Recorded messages are without ANSI colors.
+type TB struct {
+	testing.TB // Only to implement unexported methods, it's nil.
+
+	name string
+
+	mu       sync.Mutex
+	errors   []string
+	logs     []string
+	attrs    map[string]string
+	failed   bool
+	skipped  bool
+	exited   bool
+	cleanups []func()
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+// New returns a new fake TB with given name.
+func New(name string) *TB {
+	return &TB{name: name}
+}
+
+// Run calls f with t in a new goroutine and waits until it's finished
+// (like [testing.T.Run]): f stops on FailNow or SkipNow (and so on
+// Fatal, Skip, etc. or failed check of [check.Must]) or on [runtime.Goexit].
+// Then functions registered with Cleanup are called in reverse order
+// in the same goroutine, so they may also call FailNow, Fatal, etc.
+//
+// If f (or a Cleanup function) panics then the panic is re-raised
+// in the caller's goroutine after calling Cleanup functions.
+//
+// It panics if t was already used by Run.
+func (t *TB) Run(f func(t *TB)) {
+	t.mu.Lock()
+	if t.ctx != nil {
+		t.mu.Unlock()
+		panic("checktest: TB.Run called twice")
+	}
+	t.ctx, t.cancel = context.WithCancel(context.Background())
+	t.mu.Unlock()
+
+	var panicVal any
+	didPanic := false
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil { // Panic in a Cleanup function.
+				panicVal, didPanic = r, true
+			}
+		}()
+		defer func() {
+			t.cancel()
+			t.runCleanups()
+		}()
+		returned := false
+		defer func() {
+			if !returned {
+				panicVal = recover()
+				didPanic = panicVal != nil
+				t.mu.Lock()
+				t.exited = !didPanic
+				t.mu.Unlock()
+			}
+		}()
+		f(t)
+		returned = true
+	}()
+	<-done
+
+	if didPanic {
+		panic(panicVal)
+	}
+}
+
+// runCleanups calls Cleanup functions in reverse order.
+// Remaining functions are called even if one of them calls
+// [runtime.Goexit] (e.g. by FailNow) or panics.
+func (t *TB) runCleanups() {
+	t.mu.Lock()
+	if len(t.cleanups) == 0 {
+		t.mu.Unlock()
+		return
+	}
+	f := t.cleanups[len(t.cleanups)-1]
+	t.cleanups = t.cleanups[:len(t.cleanups)-1]
+	t.mu.Unlock()
+	defer t.runCleanups()
+	f()
+}
+
+// Run calls f with [check.New] wrapping a new fake TB (see [TB.Run])
+// and returns that fake TB to examine what was recorded.
+func Run(f func(t *check.TB)) *TB {
+	fake := New("checktest")
+	fake.Run(func(tb *TB) { f(check.New(tb)) })
+	return fake
+}
+
+// ExpectFailure is like [Run], but also fails tb if f didn't fail.
+func ExpectFailure(tb testing.TB, f func(t *check.TB)) *TB {
+	tb.Helper()
+	fake := Run(f)
+	if !fake.Failed() {
+		tb.Errorf("checktest: expected failure, but it passed")
+	}
+	return fake
+}
+
+// Errors returns messages reported by Error, Errorf, Fatal and Fatalf.
+func (t *TB) Errors() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.errors)
+}
+
+// Logs returns messages reported by Log, Logf, Skip, Skipf and
+// written to Output.
+func (t *TB) Logs() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.logs)
+}
+
+// Attrs returns attributes reported by Attr.
+func (t *TB) Attrs() map[string]string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return maps.Clone(t.attrs)
+}
+
+// Exited reports whether f given to Run was stopped by FailNow, SkipNow
+// or [runtime.Goexit] instead of returning.
+func (t *TB) Exited() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.exited
+}
+
+// Name implements [testing.TB].
+func (t *TB) Name() string { return t.name }
+
+// Helper implements [testing.TB].
+func (*TB) Helper() {}
+
+// Log implements [testing.TB].
+func (t *TB) Log(args ...any) { t.log(fmt.Sprintln(args...)) }
+
+// Logf implements [testing.TB].
+func (t *TB) Logf(format string, args ...any) { t.log(fmt.Sprintf(format, args...)) }
+
+func (t *TB) log(msg string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.logs = append(t.logs, ansiRE.ReplaceAllString(strings.TrimSuffix(msg, "\n"), ""))
+}
+
+// Error implements [testing.TB].
+func (t *TB) Error(args ...any) { t.error(fmt.Sprintln(args...)) }
+
+// Errorf implements [testing.TB].
+func (t *TB) Errorf(format string, args ...any) { t.error(fmt.Sprintf(format, args...)) }
+
+func (t *TB) error(msg string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.errors = append(t.errors, ansiRE.ReplaceAllString(strings.TrimSuffix(msg, "\n"), ""))
+	t.failed = true
+}
+
+// Fail implements [testing.TB].
+func (t *TB) Fail() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failed = true
+}
+
+// Failed implements [testing.TB].
+func (t *TB) Failed() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failed
+}
+
+// FailNow implements [testing.TB].
+func (t *TB) FailNow() {
+	t.Fail()
+	runtime.Goexit()
+}
+
+// Fatal implements [testing.TB].
+func (t *TB) Fatal(args ...any) {
+	t.Error(args...)
+	runtime.Goexit()
+}
+
+// Fatalf implements [testing.TB].
+func (t *TB) Fatalf(format string, args ...any) {
+	t.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// Skip implements [testing.TB].
+func (t *TB) Skip(args ...any) {
+	t.Log(args...)
+	t.SkipNow()
+}
+
+// Skipf implements [testing.TB].
+func (t *TB) Skipf(format string, args ...any) {
+	t.Logf(format, args...)
+	t.SkipNow()
+}
+
+// SkipNow implements [testing.TB].
+func (t *TB) SkipNow() {
+	t.mu.Lock()
+	t.skipped = true
+	t.mu.Unlock()
+	runtime.Goexit()
+}
+
+// Skipped implements [testing.TB].
+func (t *TB) Skipped() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.skipped
+}
+
+// Cleanup implements [testing.TB].
+func (t *TB) Cleanup(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cleanups = append(t.cleanups, f)
+}
+
+// Context implements [testing.TB].
+// It's canceled just before Cleanup functions are called.
+func (t *TB) Context() context.Context {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.ctx
+}
+
+// Attr implements [testing.TB].
+func (t *TB) Attr(key, value string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.attrs == nil {
+		t.attrs = make(map[string]string)
+	}
+	t.attrs[key] = value
+}
+
+// Output implements [testing.TB]: each written line is recorded like Log.
+func (t *TB) Output() io.Writer { return outputWriter{t} }
+
+type outputWriter struct{ t *TB }
+
+func (w outputWriter) Write(p []byte) (int, error) {
+	for line := range strings.Lines(string(p)) {
+		w.t.log(line)
+	}
+	return len(p), nil
+}
+
+// TempDir implements [testing.TB]: it creates a new directory
+// which is removed by Cleanup.
+func (t *TB) TempDir() string {
+	dir, err := os.MkdirTemp("", "checktest")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+// ArtifactDir implements [testing.TB]: it's same as TempDir.
+func (t *TB) ArtifactDir() string { return t.TempDir() }
+
+// Setenv implements [testing.TB]: it sets environment variable
+// which is restored by Cleanup.
+func (t *TB) Setenv(key, value string) {
+	prev, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		t.Fatalf("Setenv: %v", err)
+	}
+	t.Cleanup(func() {
+		if ok {
+			_ = os.Setenv(key, prev)
+		} else {
+			_ = os.Unsetenv(key)
+		}
+	})
+}
+
+// Chdir implements [testing.TB]: it changes current directory
+// which is restored by Cleanup.
+func (t *TB) Chdir(dir string) {
+	prev, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(prev) })
+}
@@ -0,0 +1,172 @@
+package checktest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/powerman/check"
+	"github.com/powerman/check/checktest"
+)
+
+func bePositive(_ *check.TB, actual any) bool {
+	return actual.(int) > 0 //nolint:forcetypeassert // Test-only.
+}
+
+func TestExpectFailure(tt *testing.T) {
+	tt.Parallel()
+	t := check.Must(tt)
+
+	fake := checktest.ExpectFailure(tt, func(t *check.TB) {
+		t.Should(bePositive, -1)
+		t.Should(bePositive, 1)
+		t.Equal(1, 2, "message")
+	})
+	t.True(fake.Failed())
+	t.False(fake.Exited())
+	t.Len(fake.Errors(), 2)
+	t.Contains(fake.Errors()[0], "Checker:  Should bePositive\n")
+	t.Contains(fake.Errors()[1], "message\nChecker:  Equal\n")
+
+	// MustAll stops f on the first failed check.
+	reached := false
+	fake = checktest.ExpectFailure(tt, func(t *check.TB) {
+		t.MustAll().Nil(errors.New("boom"))
+		reached = true
+	})
+	t.Len(fake.Errors(), 1)
+	t.True(fake.Exited())
+	t.False(reached)
+
+	// Failure when f passes is reported to the enclosing test.
+	outer := checktest.New("outer")
+	outer.Run(func(outer *checktest.TB) {
+		fake = checktest.ExpectFailure(outer, func(t *check.TB) { t.True(true) })
+	})
+	t.False(fake.Failed())
+	t.True(outer.Failed())
+	t.DeepEqual(outer.Errors(), []string{"checktest: expected failure, but it passed"})
+}
+
+func TestTB(tt *testing.T) {
+	tt.Parallel()
+	t := check.Must(tt)
+
+	var order []string
+	var ctx context.Context
+	fake := checktest.New("fake")
+	fake.Run(func(fake *checktest.TB) {
+		t.Equal(fake.Name(), "fake")
+		ctx = fake.Context()
+		fake.Cleanup(func() {
+			order = append(order, "cleanup1")
+			t.Err(ctx.Err(), context.Canceled)
+		})
+		fake.Cleanup(func() { order = append(order, "cleanup2") })
+		fake.Log("log", 1)
+		fake.Logf("logf %d", 2)
+		fmt.Fprint(fake.Output(), "out1\nout2\n")
+		fake.Attr("key", "value")
+		fake.Error("error", 1)
+		fake.Errorf("errorf %d", 2)
+		order = append(order, "fatal")
+		fake.Fatal("fatal")
+		order = append(order, "unreachable")
+	})
+	t.DeepEqual(order, []string{"fatal", "cleanup2", "cleanup1"})
+	t.True(fake.Failed())
+	t.False(fake.Skipped())
+	t.True(fake.Exited())
+	t.DeepEqual(fake.Logs(), []string{"log 1", "logf 2", "out1", "out2"})
+	t.DeepEqual(fake.Errors(), []string{"error 1", "errorf 2", "fatal"})
+	t.DeepEqual(fake.Attrs(), map[string]string{"key": "value"})
+
+	fake = checktest.New("skip")
+	fake.Run(func(fake *checktest.TB) { fake.Skipf("skip %d", 1) })
+	t.False(fake.Failed())
+	t.True(fake.Skipped())
+	t.True(fake.Exited())
+	t.DeepEqual(fake.Logs(), []string{"skip 1"})
+
+	fake = checktest.New("goexit")
+	fake.Run(func(*checktest.TB) { runtime.Goexit() })
+	t.False(fake.Failed())
+	t.True(fake.Exited())
+
+	fake = checktest.New("fail")
+	fake.Run(func(fake *checktest.TB) { fake.Fail() })
+	t.True(fake.Failed())
+	t.False(fake.Exited())
+	t.Zero(fake.Errors())
+	t.PanicMatch(func() { fake.Run(func(*checktest.TB) {}) }, `called twice`)
+
+	cleaned := false
+	fake = checktest.New("panic")
+	t.PanicValue(func() {
+		fake.Run(func(fake *checktest.TB) {
+			fake.Cleanup(func() { cleaned = true })
+			panic("oops")
+		})
+	}, "oops")
+	t.True(cleaned)
+	t.False(fake.Exited())
+
+	order = nil
+	fake = checktest.New("cleanup")
+	fake.Run(func(fake *checktest.TB) {
+		fake.Cleanup(func() { order = append(order, "cleanup1") })
+		fake.Cleanup(func() {
+			check.Must(fake).Nil(errors.New("boom"))
+			order = append(order, "unreachable")
+		})
+		fake.Cleanup(func() {
+			order = append(order, "cleanup3")
+			fake.Fatal("fatal")
+		})
+	})
+	t.DeepEqual(order, []string{"cleanup3", "cleanup1"})
+	t.True(fake.Failed())
+	t.False(fake.Exited())
+	t.Len(fake.Errors(), 2)
+	t.Equal(fake.Errors()[0], "fatal")
+	t.Contains(fake.Errors()[1], "Checker:  Nil\n")
+
+	cleaned = false
+	fake = checktest.New("cleanup panic")
+	t.PanicValue(func() {
+		fake.Run(func(fake *checktest.TB) {
+			fake.Cleanup(func() { cleaned = true })
+			fake.Cleanup(func() { panic("oops") })
+		})
+	}, "oops")
+	t.True(cleaned)
+}
+
+//nolint:paralleltest // Modifies environment and current directory.
+func TestTBEnv(tt *testing.T) {
+	t := check.Must(tt)
+
+	wd, err := os.Getwd()
+	t.Nil(err)
+	var dir string
+	fake := checktest.New("env")
+	fake.Run(func(fake *checktest.TB) {
+		dir = fake.TempDir()
+		t.DirExists(dir)
+		fake.Setenv("CHECKTEST_VAR", "value")
+		t.Equal(os.Getenv("CHECKTEST_VAR"), "value")
+		fake.Chdir(dir)
+		cwd, err := os.Getwd()
+		t.Nil(err)
+		t.Equal(cwd, dir)
+	})
+	t.NotDirExists(dir)
+	_, ok := os.LookupEnv("CHECKTEST_VAR")
+	t.False(ok)
+	cwd, err := os.Getwd()
+	t.Nil(err)
+	t.Equal(cwd, wd)
+}
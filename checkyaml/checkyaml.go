@@ -0,0 +1,56 @@
+// Package checkyaml enables YAML comparison in [check.TB.YAMLEqual].
+//
+// It lives in a separate module to keep the core check module dependency-free.
+// Just add a blank import in your test file or TestMain:
+//
+//	import _ "github.com/powerman/check/checkyaml"
+package checkyaml
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/powerman/check"
+)
+
+//nolint:gochecknoinits // Required for Normalize registration.
+func init() {
+	check.RegisterYAMLNormalizer(Normalize)
+}
+
+// Normalize returns canonical form of YAML stream doc:
+// each document is decoded and encoded back,
+// which sorts mapping keys, normalizes quoting style and indentation
+// and removes comments.
+// Documents are separated by "---" line.
+//
+// Auto-registered on loading the package.
+func Normalize(doc []byte) (string, error) {
+	var docs []string
+	dec := yaml.NewDecoder(bytes.NewReader(doc))
+	for {
+		var v any
+		err := dec.Decode(&v)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2) //nolint:mnd // Most common YAML indent.
+		err = enc.Encode(v)
+		if err == nil {
+			err = enc.Close()
+		}
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, buf.String())
+	}
+	return strings.Join(docs, "---\n"), nil
+}
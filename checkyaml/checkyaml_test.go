@@ -0,0 +1,56 @@
+package checkyaml_test
+
+import (
+	"testing"
+
+	"github.com/powerman/check"
+	"github.com/powerman/check/checkyaml"
+)
+
+func TestMain(m *testing.M) { check.TestMain(m) }
+
+func TestYAMLEqual(tt *testing.T) {
+	tt.Parallel()
+	t := check.Must(tt)
+	todo := t.TODO()
+
+	// Key order, quoting style, indentation and comments are ignored.
+	t.YAMLEqual(`
+# Comment.
+b: 2
+a: "one"
+list:
+    - x
+    - 'y'
+`, []byte(`{a: one, b: 2, list: [x, y]}`))
+	todo.YAMLEqual(`a: 1`, `a: 2`)
+	todo.YAMLEqual(`list: [x, y]`, `list: [y, x]`)
+
+	// Type matters: quoted number is a string.
+	todo.YAMLEqual(`a: "1"`, `a: 1`)
+	t.YAMLEqual(`a: 1`, `a: 0x1`)
+
+	// Multi-document streams are compared document by document.
+	t.YAMLEqual("a: 1\n---\nb: 2\n", "a: 1\n---\n# Second.\nb: 2\n")
+	todo.YAMLEqual("a: 1\n---\nb: 2\n", "b: 2\n---\na: 1\n")
+	todo.YAMLEqual("a: 1\n---\nb: 2\n", "a: 1\n")
+
+	// Empty, nil and invalid YAML always fails.
+	todo.YAMLEqual(``, ``)
+	todo.YAMLEqual(nil, nil)
+	todo.YAMLEqual(`a: [`, `a: [`)
+
+	t.Panic(func() { t.YAMLEqual(42, `a: 1`) })
+}
+
+func TestNormalize(tt *testing.T) {
+	tt.Parallel()
+	t := check.Must(tt)
+
+	s, err := checkyaml.Normalize([]byte("b: [1, 2] # Comment.\na: 'x'\n---\nc: {}\n"))
+	t.Nil(err)
+	t.Equal(s, "a: x\nb:\n  - 1\n  - 2\n---\nc: {}\n")
+
+	_, err = checkyaml.Normalize([]byte("a: ["))
+	t.NotNil(err)
+}
@@ -0,0 +1,10 @@
+module github.com/powerman/check/checkyaml
+
+go 1.25.0
+
+require (
+	github.com/powerman/check v0.0.0-00010101000000-000000000000
+	go.yaml.in/yaml/v3 v3.0.4
+)
+
+replace github.com/powerman/check => ../
@@ -0,0 +1,172 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// collectionElem is an element of a collection with its index or key.
+type collectionElem struct {
+	name  string // Index or key in square brackets.
+	value any
+}
+
+// collectionElems returns all elements of coll.
+// Map elements are sorted by key.
+//
+// Coll must be a slice, array, map, string (elements are runes,
+// indices are byte offsets like in for range) or iterator (see iterOf;
+// for iter.Seq2 keys are used like map keys).
+func collectionElems(coll any) []collectionElem {
+	var elems []collectionElem
+	if seq := iterOf(coll); seq.IsValid() {
+		i := 0
+		rangeIter(seq, func(key, value reflect.Value) {
+			name := fmt.Sprintf("[%d]", i)
+			if key.IsValid() {
+				name = "[" + matcherArg(valueOf(key)) + "]"
+			}
+			elems = append(elems, collectionElem{name, valueOf(value)})
+			i++
+		})
+		return elems
+	}
+	switch val := reflect.ValueOf(coll); val.Kind() { //nolint:exhaustive // Covered by default case.
+	case reflect.Slice, reflect.Array:
+		for i := range val.Len() {
+			elems = append(elems, collectionElem{fmt.Sprintf("[%d]", i), val.Index(i).Interface()})
+		}
+	case reflect.Map:
+		for _, k := range sortedMapKeys(val) {
+			elems = append(elems, collectionElem{"[" + matcherArg(k.Interface()) + "]", val.MapIndex(k).Interface()})
+		}
+	case reflect.String:
+		for i, r := range val.String() {
+			elems = append(elems, collectionElem{fmt.Sprintf("[%d]", i), r})
+		}
+	default:
+		panic("actual is not a slice, array, map or string")
+	}
+	return elems
+}
+
+// predicate returns name of pred and a func to call it.
+//
+// Pred must be either a func(T) bool (element must be assignable to T)
+// or a [ShouldFunc1] (it'll be called with t).
+func predicate(t *TB, pred any) (string, func(any) bool) {
+	switch f := pred.(type) {
+	case ShouldFunc1:
+		return funcName(f), func(v any) bool { return f(t, v) }
+	case func(t *TB, actual any) bool:
+		return funcName(f), func(v any) bool { return f(t, v) }
+	}
+	f := reflect.ValueOf(pred)
+	if f.Kind() != reflect.Func || f.Type().NumIn() != 1 || f.Type().NumOut() != 1 ||
+		f.Type().Out(0).Kind() != reflect.Bool {
+		panic("pred is not a func(T) bool or ShouldFunc1")
+	}
+	return funcName(pred), func(v any) bool {
+		arg, typ := reflect.ValueOf(v), f.Type().In(0)
+		switch {
+		case !arg.IsValid() && isNil(reflect.Zero(typ).Interface()):
+			arg = reflect.Zero(typ)
+		case !arg.IsValid() || !arg.Type().AssignableTo(typ):
+			panic(fmt.Sprintf("element %#v is not assignable to pred's arg of type %s", v, typ))
+		}
+		return f.Call([]reflect.Value{arg})[0].Bool()
+	}
+}
+
+// AllMatch checks that pred returns true for every element of actual.
+//
+// Actual must be a slice, array, map (pred is called with values),
+// string (pred is called with runes) or iterator (see [TB.Contains];
+// for iter.Seq2 pred is called with values).
+// Pred must be either a func(T) bool (every element must be assignable to T)
+// or a [ShouldFunc1].
+//
+// On failure it shows only elements not matching pred (with their indices or keys).
+func (t *checks) AllMatch(actual, pred any, msg ...any) bool {
+	t.tb.Helper()
+	name, f := predicate(&TB{TB: t.tb, checks: t}, pred)
+	elems := collectionElems(actual)
+	failed := filterElems(elems, func(v any) bool { return !f(v) })
+	return t.reportElems(len(failed) == 0, msg,
+		fmt.Sprintf("%d of %d elements don't match %s", len(failed), len(elems), name),
+		"Not matching", failed)
+}
+
+// AnyMatch checks that pred returns true for at least one element of actual.
+//
+// See AllMatch about supported actual and pred types.
+func (t *checks) AnyMatch(actual, pred any, msg ...any) bool {
+	t.tb.Helper()
+	name, f := predicate(&TB{TB: t.tb, checks: t}, pred)
+	elems := collectionElems(actual)
+	matched := filterElems(elems, f)
+	return t.reportElems(len(matched) > 0, msg,
+		fmt.Sprintf("%d of %d elements match %s", len(matched), len(elems), name),
+		"Matching", matched)
+}
+
+// NoneMatch checks that pred returns false for every element of actual.
+//
+// See AllMatch about supported actual and pred types.
+//
+// On failure it shows only elements matching pred (with their indices or keys).
+func (t *checks) NoneMatch(actual, pred any, msg ...any) bool {
+	t.tb.Helper()
+	name, f := predicate(&TB{TB: t.tb, checks: t}, pred)
+	elems := collectionElems(actual)
+	matched := filterElems(elems, f)
+	return t.reportElems(len(matched) == 0, msg,
+		fmt.Sprintf("%d of %d elements match %s", len(matched), len(elems), name),
+		"Matching", matched)
+}
+
+// CountMatch checks that pred returns true for exactly expected elements of actual.
+//
+// See AllMatch about supported actual and pred types.
+//
+// On failure it shows only elements matching pred (with their indices or keys).
+func (t *checks) CountMatch(actual, pred any, expected int, msg ...any) bool {
+	t.tb.Helper()
+	name, f := predicate(&TB{TB: t.tb, checks: t}, pred)
+	elems := collectionElems(actual)
+	matched := filterElems(elems, f)
+	return t.reportElems(len(matched) == expected, msg,
+		fmt.Sprintf("%d of %d elements match %s, expected %d", len(matched), len(elems), name, expected),
+		"Matching", matched)
+}
+
+func filterElems(elems []collectionElem, f func(any) bool) []collectionElem {
+	var filtered []collectionElem
+	for _, elem := range elems {
+		if f(elem.value) {
+			filtered = append(filtered, elem)
+		}
+	}
+	return filtered
+}
+
+// reportElems reports summary as actual and explains it with elems
+// (instead of showing whole collection).
+func (c *checks) reportElems(ok bool, msg []any, summary, title string, elems []collectionElem) bool {
+	c.tb.Helper()
+	return c.reportExplained(ok, msg,
+		callerFuncName(1),
+		[]string{nameActual},
+		[]any{note(summary)},
+		func() string {
+			if len(elems) == 0 {
+				return ""
+			}
+			name := make([]string, len(elems))
+			values := make([]any, len(elems))
+			for i, elem := range elems {
+				name[i], values[i] = elem.name, elem.value
+			}
+			return explain(title, name, values)
+		})
+}
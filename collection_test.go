@@ -0,0 +1,93 @@
+package check_test
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/powerman/check"
+)
+
+func isPositive(v int) bool { return v > 0 }
+
+func bePositiveAny(_ *check.TB, actual any) bool {
+	v, ok := actual.(int)
+	return ok && v > 0
+}
+
+func TestAllMatch(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.AllMatch([]int{1, 2, 3}, isPositive)
+	t.AllMatch([3]int{1, 2, 3}, isPositive)
+	t.AllMatch(map[string]int{"a": 1, "b": 2}, isPositive)
+	t.AllMatch("ABC", unicode.IsUpper)
+	t.AllMatch([]int{}, isPositive)
+	t.AllMatch([]int(nil), isPositive)
+	t.AllMatch([]any{1, 2}, bePositiveAny)
+	t.AllMatch([]any{1, 2}, check.ShouldFunc1(bePositiveAny))
+	t.AllMatch([]any{"a", nil}, func(v any) bool { return v != 1 })
+	t.AllMatch([]*int{nil}, func(v *int) bool { return v == nil })
+	todo.AllMatch([]int{1, -2, 3, -4}, isPositive)
+	todo.AllMatch(map[string]int{"a": 1, "b": -2}, isPositive)
+	todo.AllMatch("AbC", unicode.IsUpper)
+	todo.AllMatch([]any{1, "2"}, bePositiveAny)
+
+	t.PanicMatch(func() { t.AllMatch(42, isPositive) }, "actual is not a slice, array, map or string")
+	t.PanicMatch(func() { t.AllMatch(nil, isPositive) }, "actual is not a slice, array, map or string")
+	t.PanicMatch(func() { t.AllMatch([]int{1}, 42) }, "pred is not a func")
+	t.PanicMatch(func() { t.AllMatch([]int{1}, func(int) int { return 0 }) }, "pred is not a func")
+	t.PanicMatch(func() { t.AllMatch([]int{1}, func(int, int) bool { return true }) }, "pred is not a func")
+	t.PanicMatch(func() { t.AllMatch([]any{1, "2"}, isPositive) }, `element "2" is not assignable to pred's arg of type int`)
+	t.PanicMatch(func() { t.AllMatch([]any{nil}, isPositive) }, `element <nil> is not assignable`)
+}
+
+func TestAnyNoneCountMatch(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.AnyMatch([]int{-1, 2, -3}, isPositive)
+	t.AnyMatch(map[int]int{1: -1, 2: 2}, isPositive)
+	t.AnyMatch("abC", unicode.IsUpper)
+	t.AnyMatch([]any{"x", 1}, bePositiveAny)
+	todo.AnyMatch([]int{-1, -2}, isPositive)
+	todo.AnyMatch([]int{}, isPositive)
+	todo.AnyMatch("", unicode.IsUpper)
+
+	t.NoneMatch([]int{-1, -2}, isPositive)
+	t.NoneMatch([]int{}, isPositive)
+	t.NoneMatch("abc", unicode.IsUpper)
+	todo.NoneMatch([]int{-1, 2, -3}, isPositive)
+	todo.NoneMatch(map[string]any{"a": 1}, bePositiveAny)
+
+	t.CountMatch([]int{-1, 2, 3}, isPositive, 2)
+	t.CountMatch([]int{}, isPositive, 0)
+	t.CountMatch("aBcD", unicode.IsUpper, 2)
+	t.CountMatch("日本Go", unicode.IsUpper, 1)
+	todo.CountMatch([]int{-1, 2, 3}, isPositive, 1)
+	todo.CountMatch([]int{-1, 2, 3}, isPositive, 3)
+}
+
+func TestCollectionMatchReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeCollectionMatchReport"}
+	t := check.New(fake)
+
+	t.AllMatch([]int{1, -2, 3, -4}, isPositive)
+	t.NoneMatch(map[string]int{"a": 1, "b": -2}, isPositive)
+	t.CountMatch("aBc", unicode.IsUpper, 2)
+	t.AnyMatch([]int{-1}, isPositive)
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 4)
+	realT.Contains(fake.msgs[0], "Actual:   2 of 4 elements don't match isPositive\n")
+	realT.Contains(fake.msgs[0], "Not matching:\n  [1]: (int) -2\n  [3]: (int) -4\n")
+	realT.Contains(fake.msgs[1], "Actual:   1 of 2 elements match isPositive\n")
+	realT.Contains(fake.msgs[1], "Matching:\n  [\"a\"]: (int) 1\n")
+	realT.Contains(fake.msgs[2], "Actual:   1 of 3 elements match IsUpper, expected 2\n")
+	realT.Contains(fake.msgs[2], "Matching:\n  [1]: (int32) 'B'\n")
+	realT.Contains(fake.msgs[3], "Actual:   0 of 1 elements match isPositive\n")
+	realT.NotContains(fake.msgs[3], "Matching")
+}
@@ -0,0 +1,424 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	histogramBins  = 10
+	histogramWidth = 40
+)
+
+// MeanInDelta checks that arithmetic mean of a sample actual is within
+// expected-delta <= mean <= expected+delta.
+//
+// Actual must be a slice or array of numbers (signed integers,
+// unsigned integers or floats).
+// Mean of an empty sample is NaN, so check will fail.
+//
+// On failure it shows computed mean, statistics and histogram of a sample.
+func (t *checks) MeanInDelta(actual any, expected, delta float64, msg ...any) bool {
+	t.tb.Helper()
+	sample := sampleOf(actual)
+	mean := sampleMean(sample)
+	return t.reportSample(isInDelta(mean, expected, delta, false), msg,
+		[]string{nameActual, nameExpected, "Delta"},
+		[]any{mean, expected, delta},
+		sample)
+}
+
+// StdDevInDelta checks that sample standard deviation (with Bessel's
+// correction, i.e. divided by n-1) of a sample actual is within
+// expected-delta <= stddev <= expected+delta.
+//
+// See MeanInDelta about supported actual types.
+// Standard deviation of a sample with less than 2 values is NaN,
+// so check will fail.
+//
+// On failure it shows computed stddev, statistics and histogram of a sample.
+func (t *checks) StdDevInDelta(actual any, expected, delta float64, msg ...any) bool {
+	t.tb.Helper()
+	sample := sampleOf(actual)
+	stddev := sampleStdDev(sample)
+	return t.reportSample(isInDelta(stddev, expected, delta, false), msg,
+		[]string{nameActual, nameExpected, "Delta"},
+		[]any{stddev, expected, delta},
+		sample)
+}
+
+// QuantileInRange checks that q-quantile of a sample actual is within
+// minimum <= quantile <= maximum.
+//
+// See MeanInDelta about supported actual types.
+// Quantile is computed using linear interpolation between closest ranks
+// (like R's default and numpy's "linear" method), q must be 0 <= q <= 1
+// (e.g. 0.5 for median, 0.99 for 99th percentile).
+// Quantile of an empty sample is NaN, so check will fail.
+//
+// On failure it shows computed quantile, statistics and histogram of a sample.
+func (t *checks) QuantileInRange(actual any, q, minimum, maximum float64, msg ...any) bool {
+	t.tb.Helper()
+	if !(0 <= q && q <= 1) {
+		panic("q is not in allowed range: 0 <= q <= 1")
+	}
+	sample := sampleOf(actual)
+	quantile := sampleQuantile(sorted(sample), q)
+	return t.reportSample(minimum <= quantile && quantile <= maximum, msg,
+		[]string{nameActual, "Min", "Max", "Quantile"},
+		[]any{quantile, minimum, maximum, q},
+		sample)
+}
+
+// ChiSquareFit checks using Pearson's chi-square goodness-of-fit test
+// that a sample actual fits distribution expected with significance level
+// alpha, i.e. it fails if p-value < alpha.
+//
+// Actual must be a slice or array of observed values (e.g. bucket numbers
+// or chosen backends) and expected must be a map from these values to
+// their expected relative frequencies (numbers, they'll be normalized
+// to sum of all frequencies).
+// Observed value missing in expected or with zero frequency fails the check.
+// Allowed alpha values are: 0.0 < alpha < 1.0 (usually 0.01 or 0.05).
+//
+// To get a reliable result expected amount of observations in each bucket
+// (i.e. len(actual) * normalized frequency) should be at least 5.
+// Keep in mind even with a correct distribution check will fail with
+// probability alpha, so use fixed random seed when possible.
+//
+// On failure it shows computed p-value, chi-square statistics and
+// histogram with observed and expected amount of values in each bucket.
+func (t *checks) ChiSquareFit(actual, expected any, alpha float64, msg ...any) bool {
+	t.tb.Helper()
+	if !(0 < alpha && alpha < 1) {
+		panic("alpha is not in allowed range: 0 < alpha < 1")
+	}
+	fit := chiSquareFitOf(actual, expected)
+	return t.reportExplained(fit.pValue >= alpha, msg,
+		callerFuncName(0),
+		[]string{nameActual, "Alpha"},
+		[]any{fit.pValue, alpha},
+		fit.explain)
+}
+
+// sampleOf returns values of slice or array of numbers as float64.
+func sampleOf(actual any) []float64 {
+	val := reflect.ValueOf(actual)
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		panic("actual is not a slice or array")
+	}
+	sample := make([]float64, val.Len())
+	for i := range sample {
+		sample[i] = floatOf(val.Index(i), "actual element")
+	}
+	return sample
+}
+
+// floatOf returns v as float64 or panics if v isn't a real number
+// (integer or float).
+func floatOf(v reflect.Value, name string) float64 {
+	switch v.Kind() { //nolint:exhaustive // Covered by default case.
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	default:
+		panic(name + " is not a number")
+	}
+}
+
+func sorted(sample []float64) []float64 {
+	sample = slices.Clone(sample)
+	slices.Sort(sample)
+	return sample
+}
+
+func sampleMean(sample []float64) float64 {
+	if len(sample) == 0 {
+		return math.NaN()
+	}
+	sum := 0.0
+	for _, v := range sample {
+		sum += v
+	}
+	return sum / float64(len(sample))
+}
+
+func sampleStdDev(sample []float64) float64 {
+	if len(sample) < 2 { //nolint:mnd // Bessel's correction.
+		return math.NaN()
+	}
+	mean, sum := sampleMean(sample), 0.0
+	for _, v := range sample {
+		sum += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(sum / float64(len(sample)-1))
+}
+
+// sampleQuantile returns q-quantile of sorted sample.
+func sampleQuantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+	pos := q * float64(len(sorted)-1)
+	i := int(pos)
+	if i == len(sorted)-1 {
+		return sorted[i]
+	}
+	return sorted[i] + (pos-float64(i))*(sorted[i+1]-sorted[i])
+}
+
+// reportSample reports failure with statistics and histogram of a sample.
+func (c *checks) reportSample(ok bool, msg []any, name []string, args []any, sample []float64) bool {
+	c.tb.Helper()
+	return c.reportExplained(ok, msg,
+		callerFuncName(1),
+		name,
+		args,
+		func() string { return explainSample(sample) })
+}
+
+func explainSample(sample []float64) string {
+	s := sorted(sample)
+	var buf strings.Builder
+	buf.WriteString(explain("Statistics",
+		[]string{"n", "mean", "stddev", "min", "p50", "p90", "p99", "max"},
+		[]any{
+			note(fmt.Sprint(len(s))),
+			note(fmt.Sprintf("%.6g", sampleMean(s))),
+			note(fmt.Sprintf("%.6g", sampleStdDev(s))),
+			note(fmt.Sprintf("%.6g", sampleQuantile(s, 0))),
+			note(fmt.Sprintf("%.6g", sampleQuantile(s, 0.5))),  //nolint:mnd // Median.
+			note(fmt.Sprintf("%.6g", sampleQuantile(s, 0.9))),  //nolint:mnd // Percentile.
+			note(fmt.Sprintf("%.6g", sampleQuantile(s, 0.99))), //nolint:mnd // Percentile.
+			note(fmt.Sprintf("%.6g", sampleQuantile(s, 1))),
+		}))
+	if len(s) == 0 {
+		return buf.String()
+	}
+
+	lo, hi := s[0], s[len(s)-1]
+	bins := histogramBins
+	if lo == hi || math.IsInf(lo, 0) || math.IsInf(hi, 0) || math.IsNaN(lo) || math.IsNaN(hi) {
+		bins = 1
+	}
+	counts := make([]int, bins)
+	width := (hi - lo) / float64(bins)
+	for _, v := range s {
+		i := 0
+		if bins > 1 {
+			i = min(int((v-lo)/width), bins-1)
+		}
+		counts[i]++
+	}
+	maxCount := slices.Max(counts)
+	countWidth := len(strconv.Itoa(maxCount))
+	name := make([]string, bins)
+	values := make([]any, bins)
+	for i := range counts {
+		closing := ")"
+		if i == bins-1 {
+			closing = "]"
+		}
+		binHi := lo + float64(i+1)*width
+		if i == bins-1 {
+			binHi = hi
+		}
+		name[i] = fmt.Sprintf("[%.4g, %.4g%s", lo+float64(i)*width, binHi, closing)
+		values[i] = note(fmt.Sprintf("%*d %s", countWidth, counts[i], histogramBar(float64(counts[i]), float64(maxCount))))
+	}
+	buf.WriteString(explain("Histogram", alignNames(name), values))
+	return buf.String()
+}
+
+// histogramBar returns bar with length proportional to v/maximum.
+func histogramBar(v, maximum float64) string {
+	if maximum <= 0 {
+		return ""
+	}
+	return strings.Repeat("█", int(math.Round(v/maximum*histogramWidth)))
+}
+
+// alignNames pads names with spaces to make them same length.
+func alignNames(name []string) []string {
+	width := 0
+	for _, n := range name {
+		width = max(width, len(n))
+	}
+	aligned := make([]string, len(name))
+	for i, n := range name {
+		aligned[i] = n + strings.Repeat(" ", width-len(n))
+	}
+	return aligned
+}
+
+// chiSquareFit is a result of chi-square goodness-of-fit test.
+type chiSquareFit struct {
+	pValue     float64
+	chiSquare  float64
+	df         int
+	buckets    []string
+	observed   []int
+	expected   []float64
+	unexpected []string // Observed values missing in expected.
+}
+
+func chiSquareFitOf(actual, expected any) *chiSquareFit {
+	va, ve := reflect.ValueOf(actual), reflect.ValueOf(expected)
+	if va.Kind() != reflect.Slice && va.Kind() != reflect.Array {
+		panic("actual is not a slice or array")
+	}
+	if ve.Kind() != reflect.Map {
+		panic("expected is not a map")
+	}
+	keys := sortedMapKeys(ve)
+	index := make(map[any]int, len(keys))
+	weights := make([]float64, len(keys))
+	total := 0.0
+	for i, k := range keys {
+		index[k.Interface()] = i
+		weights[i] = floatOf(reflect.ValueOf(ve.MapIndex(k).Interface()), "expected frequency")
+		if weights[i] < 0 {
+			panic("expected frequency is negative")
+		}
+		total += weights[i]
+	}
+	if total == 0 {
+		panic("expected frequencies are all zero")
+	}
+
+	fit := &chiSquareFit{pValue: 1}
+	counts := make([]int, len(keys))
+	for i := range va.Len() {
+		v := va.Index(i)
+		if !v.IsValid() || (v.Kind() == reflect.Interface && v.IsNil()) {
+			panic("actual element is not assignable to expected key")
+		}
+		if v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		if !v.Type().AssignableTo(ve.Type().Key()) {
+			panic(fmt.Sprintf("actual element of type %s is not assignable to expected key of type %s", v.Type(), ve.Type().Key()))
+		}
+		k := reflect.New(ve.Type().Key()).Elem()
+		k.Set(v)
+		j, ok := index[k.Interface()]
+		if !ok || weights[j] == 0 {
+			fit.unexpected = append(fit.unexpected, matcherArg(v.Interface()))
+			continue
+		}
+		counts[j]++
+	}
+
+	n := float64(va.Len())
+	for i, k := range keys {
+		if weights[i] == 0 {
+			continue
+		}
+		exp := n * weights[i] / total
+		fit.buckets = append(fit.buckets, matcherArg(k.Interface()))
+		fit.observed = append(fit.observed, counts[i])
+		fit.expected = append(fit.expected, exp)
+		if exp > 0 {
+			d := float64(counts[i]) - exp
+			fit.chiSquare += d * d / exp
+		}
+	}
+	fit.df = len(fit.buckets) - 1
+	switch {
+	case len(fit.unexpected) > 0:
+		fit.pValue = 0
+	case fit.df > 0 && n > 0:
+		fit.pValue = chiSquareSurvival(fit.chiSquare, fit.df)
+	}
+	return fit
+}
+
+func (fit *chiSquareFit) explain() string {
+	var buf strings.Builder
+	buf.WriteString(explain("Statistics",
+		[]string{"chi-square", "df", "p-value"},
+		[]any{
+			note(fmt.Sprintf("%.6g", fit.chiSquare)),
+			note(fmt.Sprint(fit.df)),
+			note(fmt.Sprintf("%.6g", fit.pValue)),
+		}))
+	if len(fit.unexpected) > 0 {
+		slices.Sort(fit.unexpected)
+		buf.WriteString(explain("Unexpected",
+			[]string{"values"},
+			[]any{note(strings.Join(slices.Compact(fit.unexpected), ", "))}))
+	}
+	maximum := 0.0
+	for i := range fit.buckets {
+		maximum = max(maximum, float64(fit.observed[i]), fit.expected[i])
+	}
+	values := make([]any, len(fit.buckets))
+	for i := range fit.buckets {
+		values[i] = note(fmt.Sprintf("observed %d, expected %.1f %s",
+			fit.observed[i], fit.expected[i], histogramBar(float64(fit.observed[i]), maximum)))
+	}
+	buf.WriteString(explain("Histogram", alignNames(fit.buckets), values))
+	return buf.String()
+}
+
+// chiSquareSurvival returns P(X >= x) for X having chi-square distribution
+// with df degrees of freedom.
+func chiSquareSurvival(x float64, df int) float64 {
+	return gammaQ(float64(df)/2, x/2) //nolint:mnd // By definition.
+}
+
+// gammaQ returns regularized upper incomplete gamma function Q(a, x).
+func gammaQ(a, x float64) float64 {
+	const (
+		maxIter = 1000
+		eps     = 1e-15
+		tiny    = 1e-300
+	)
+	if x <= 0 {
+		return 1
+	}
+	lg, _ := math.Lgamma(a)
+	factor := math.Exp(-x + a*math.Log(x) - lg)
+	if x < a+1 {
+		// Series for P(a, x).
+		sum, term := 1/a, 1/a
+		for n := 1; n < maxIter; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*eps {
+				break
+			}
+		}
+		return max(0, 1-sum*factor)
+	}
+	// Continued fraction for Q(a, x) (modified Lentz's method).
+	b := x + 1 - a
+	c, d := 1/tiny, 1/b
+	h := d
+	for i := 1; i < maxIter; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+	return factor * h
+}
@@ -0,0 +1,135 @@
+package check_test
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+func normalSample(n int, mean, stddev float64) []float64 {
+	rng := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // Test.
+	sample := make([]float64, n)
+	for i := range sample {
+		sample[i] = mean + stddev*rng.NormFloat64()
+	}
+	return sample
+}
+
+func TestMeanStdDevInDelta(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	sample := normalSample(10000, 5, 2)
+
+	t.MeanInDelta(sample, 5, 0.1)
+	t.MeanInDelta([]int{1, 2, 3, 4}, 2.5, 0)
+	t.MeanInDelta([3]uint8{1, 2, 3}, 2, 0)
+	todo.MeanInDelta(sample, 6, 0.1)
+	todo.MeanInDelta([]float64{}, 0, 1)
+
+	t.StdDevInDelta(sample, 2, 0.1)
+	t.StdDevInDelta([]int{2, 4, 4, 4, 5, 5, 7, 9}, 2.138, 0.001)
+	todo.StdDevInDelta(sample, 1, 0.1)
+	todo.StdDevInDelta([]float64{1}, 0, 1)
+
+	t.PanicMatch(func() { t.MeanInDelta(42, 0, 1) }, "actual is not a slice or array")
+	t.PanicMatch(func() { t.StdDevInDelta([]string{"1"}, 0, 1) }, "actual element is not a number")
+}
+
+func TestQuantileInRange(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	sample := normalSample(10000, 0, 1)
+
+	t.QuantileInRange(sample, 0.5, -0.05, 0.05)
+	t.QuantileInRange(sample, 0.975, 1.9, 2.0)
+	t.QuantileInRange([]int{1, 2, 3, 4}, 0.5, 2.5, 2.5)
+	t.QuantileInRange([]int{1, 2, 3, 4}, 0, 1, 1)
+	t.QuantileInRange([]int{1, 2, 3, 4}, 1, 4, 4)
+	t.QuantileInRange([]int{1, 2, 3, 4}, 0.25, 1.75, 1.75)
+	t.QuantileInRange([]int{4, 3, 2, 1}, 0.9, 3.7, 3.7)
+	todo.QuantileInRange(sample, 0.99, 0, 2)
+	todo.QuantileInRange([]int{}, 0.5, -1, 1)
+
+	t.PanicMatch(func() { t.QuantileInRange(sample, 1.5, 0, 1) }, `q is not in allowed range`)
+	t.PanicMatch(func() { t.QuantileInRange(sample, -0.1, 0, 1) }, `q is not in allowed range`)
+}
+
+func TestChiSquareFit(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	rng := rand.New(rand.NewPCG(3, 4)) //nolint:gosec // Test.
+
+	dice := make([]int, 6000)
+	for i := range dice {
+		dice[i] = 1 + rng.IntN(6)
+	}
+	uniform := map[int]int{1: 1, 2: 1, 3: 1, 4: 1, 5: 1, 6: 1}
+	t.ChiSquareFit(dice, uniform, 0.01)
+	todo.ChiSquareFit(dice, map[int]float64{1: 2, 2: 1, 3: 1, 4: 1, 5: 1, 6: 1}, 0.01)
+	todo.ChiSquareFit(dice, map[int]int{1: 1, 2: 1, 3: 1, 4: 1, 5: 1}, 0.01)
+	todo.ChiSquareFit(dice, map[int]int{1: 1, 2: 1, 3: 1, 4: 1, 5: 1, 6: 0}, 0.01)
+
+	weighted := make([]string, 4000)
+	for i := range weighted {
+		if rng.IntN(4) == 0 {
+			weighted[i] = "b"
+		} else {
+			weighted[i] = "a"
+		}
+	}
+	t.ChiSquareFit(weighted, map[string]float64{"a": 0.75, "b": 0.25, "c": 0}, 0.01)
+	t.ChiSquareFit([]any{"a", "a"}, map[string]int{"a": 1}, 0.5)
+	todo.ChiSquareFit(weighted, map[string]float64{"a": 0.5, "b": 0.5}, 0.01)
+
+	// Exact chi-square distribution values: P(X >= 3.841) = 0.05 for df = 1.
+	t.ChiSquareFit([]int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, map[int]int{1: 1, 2: 1}, 0.999)
+	t.ChiSquareFit(repeat([]int{1, 2}, []int{10096, 9904}), map[int]int{1: 1, 2: 1}, 0.17)
+	todo.ChiSquareFit(repeat([]int{1, 2}, []int{10096, 9904}), map[int]int{1: 1, 2: 1}, 0.18)
+
+	t.PanicMatch(func() { t.ChiSquareFit(dice, uniform, 0) }, `alpha is not in allowed range`)
+	t.PanicMatch(func() { t.ChiSquareFit(42, uniform, 0.05) }, `actual is not a slice or array`)
+	t.PanicMatch(func() { t.ChiSquareFit(dice, []int{1}, 0.05) }, `expected is not a map`)
+	t.PanicMatch(func() { t.ChiSquareFit(dice, map[int]int{1: -1}, 0.05) }, `expected frequency is negative`)
+	t.PanicMatch(func() { t.ChiSquareFit(dice, map[int]int{1: 0}, 0.05) }, `expected frequencies are all zero`)
+	t.PanicMatch(func() { t.ChiSquareFit(dice, map[int]string{1: "1"}, 0.05) }, `expected frequency is not a number`)
+	t.PanicMatch(func() { t.ChiSquareFit(dice, map[int]complex128{1: 1 + 1i}, 0.05) }, `expected frequency is not a number`)
+	t.PanicMatch(func() { t.ChiSquareFit([]int{1}, map[int]any{1: 1, 2: 1i}, 0.05) }, `expected frequency is not a number`)
+	t.PanicMatch(func() { t.ChiSquareFit([]string{"1"}, uniform, 0.05) }, `actual element of type string is not assignable to expected key of type int`)
+}
+
+// repeat returns slice with values[i] repeated counts[i] times.
+func repeat(values, counts []int) []int {
+	var res []int
+	for i, v := range values {
+		for range counts[i] {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func TestDistributionReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeDistributionReport"}
+	t := check.New(fake)
+
+	t.MeanInDelta([]int{1, 2, 2, 3, 3, 3, 10}, 3, 0.4)
+	t.ChiSquareFit([]string{"a", "a", "a", "b", "x"}, map[string]int{"a": 1, "b": 1}, 0.05)
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 2)
+	realT.Contains(fake.msgs[0], "Actual:   (float64) 3.4285714285714284\n")
+	realT.Contains(fake.msgs[0], "Statistics:\n  n: 7\n  mean: 3.42857\n")
+	realT.Contains(fake.msgs[0], "  max: 10\n")
+	realT.Contains(fake.msgs[0], "Histogram:\n  [1, 1.9)  : 1 "+strings.Repeat("█", 13)+"\n")
+	realT.Contains(fake.msgs[0], "  [9.1, 10] : 1 ")
+	realT.Contains(fake.msgs[1], "Alpha:    (float64) 0.05\n")
+	realT.Contains(fake.msgs[1], "Actual:   (float64) 0\n")
+	realT.Contains(fake.msgs[1], "Unexpected:\n  values: \"x\"\n")
+	realT.Contains(fake.msgs[1], "Histogram:\n  \"a\": observed 3, expected 2.5 "+strings.Repeat("█", 40)+"\n")
+}
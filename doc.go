@@ -85,10 +85,20 @@
 //	t.ErrIs(err, io.EOF)            // errors.Is(err, io.EOF)
 //	t.ErrAs(err, &targetType)       // errors.As(err, &targetType)
 //
+//	// Multi-errors (errors.Join, etc.):
+//	t.ErrContainsAll(err, []error{io.EOF, ErrNotFound})
+//	t.ErrExactly(err, []error{io.EOF, ErrNotFound}) // and nothing else
+//
+//	// More specific checks:
+//	t.ErrOneOf(err, []error{io.EOF, io.ErrUnexpectedEOF})
+//	t.ErrAsFunc(err, func(e *MyErr) bool { return e.Code == 404 })
+//	t.ErrMatch(err, ErrNotFound, `user \d+`) // errors.Is + regexp
+//
 // When to use which:
 //
-//   - Err    — same type and value (unwraps to root, compares by value),
-//     support for extra custom error types (e.g. gRPC status or validator.FieldError)
+//   - Err    — same type and value (searches whole tree of wrapped errors, compares by value),
+//     support for extra custom error types (e.g. gRPC status or validator.FieldError,
+//     compared by Namespace and Tag or by parts given to [TB.FieldErrorKeys])
 //   - ErrIs  — standard [errors.Is] (not value comparison)
 //   - ErrAs  — extract the first matching error type
 //   - Match  — check by error text against a regexp
@@ -124,6 +134,8 @@
 //
 // ★ If you need custom check, which isn't available out-of-box - see
 // [Should] checker, it'll let you plug in your own checker with ease.
+// To unit-test such checkers and your test helpers use a fake [testing.TB]
+// from package github.com/powerman/check/checktest.
 //
 // ★ It will panic when called with arg of wrong type - because this
 // means bug in your test.
@@ -158,6 +170,45 @@
 //	t := check.Must(tt).MergeContext(appCtx)
 //	t.Context() // merged values and cancellation from both contexts
 //
+// ★ Checkers which have to wait (like [TB.Receive]) are bounded by
+// t.Context() (incl. contexts merged with [TB.MergeContext]) and,
+// optionally, by [TB.Timeout]:
+//
+//	t.Timeout(time.Second).Receive(ch, want)
+//	t.Timeout(100*time.Millisecond).NotReceive(ch)
+//
+// ★ Run a test in a [testing/synctest] bubble without extra plumbing -
+// t inside the bubble has same mode, merged context values and statistics:
+//
+//	t.Synctest(func(t *check.TB) {
+//		go worker()
+//		t.Advance(time.Minute).Equal(counter.Load(), 1)
+//	})
+//
+// ★ Check the test doesn't leak goroutines, file descriptors or temp files
+// (don't use it in parallel tests):
+//
+//	t := check.Must(tt)
+//	t.NoLeaks()
+//
+// ★ Check only some parts of a value exactly, using [Matcher] for the rest:
+//
+//	t.DeepEqual(resp, map[string]any{
+//		"id":    check.Gt(0),
+//		"name":  "Alice",
+//		"email": check.Re(`@`),
+//	})
+//
+// ★ Collection checkers like [TB.Len], [TB.Contains], [TB.SortEqual],
+// [TB.Subset] and [TB.AllMatch] also accept iterators
+// (iter.Seq, iter.Seq2 or a type with All method returning one of them) -
+// they're drained and dumps show yielded elements:
+//
+//	t.SortEqual(maps.Keys(m), []string{"a", "b"})
+//	t.Len(set, 2)
+//
+// [TB.Zero] and [TB.NotZero] drain only iter.Seq and iter.Seq2 func values.
+//
 // ★ Enable Protobuf message comparison and gRPC status error comparison by:
 //
 //	import _ "github.com/powerman/checkgrpc"
@@ -165,6 +216,10 @@
 // This enables proto.Equal for protobuf messages in [DeepEqual]/[NotDeepEqual]
 // and gRPC status comparison in [Err]/[NotErr].
 //
+// ★ Enable YAML comparison in [YAMLEqual] by:
+//
+//	import _ "github.com/powerman/check/checkyaml"
+//
 // # Contents
 //
 // Constructors:
@@ -179,6 +234,10 @@
 //	Fail      FailNow
 //	Must      MustAll
 //	Should
+//	Synctest  Wait  Advance
+//	Try       AnyOf  OneOf
+//	Timeout   UseNumber   IgnoreXMLSpace   EqualNaN
+//	FieldErrorKeys
 //	TODO
 //
 // Everything else are just trivial (mostly) checkers which works in
@@ -191,11 +250,18 @@
 //
 //	Equal           NotEqual           EQ  NE
 //	DeepEqual       NotDeepEqual
+//	Like            NotLike
 //	Err             NotErr
 //	ErrIs           NotErrIs
 //	ErrAs           NotErrAs
+//	ErrContainsAll  ErrExactly
+//	ErrOneOf        ErrAsFunc          ErrMatch
 //	BytesEqual      NotBytesEqual
-//	JSONEqual
+//	JSONEqual       JSONPath
+//	JSONSubset      JSONSubsetUnordered
+//	JSONSchema
+//	YAMLEqual
+//	XMLEqual
 //
 //	Greater         LessOrEqual        GT  LE
 //	Less            GreaterOrEqual     LT  GE
@@ -203,6 +269,12 @@
 //	BetweenOrEqual  NotBetweenOrEqual
 //	InDelta         NotInDelta
 //	InSMAPE         NotInSMAPE
+//	InEpsilon       NotInEpsilon
+//	InULP           NotInULP
+//	InDeltaEach     InSMAPEEach
+//	InEpsilonEach   InULPEach
+//	MeanInDelta     StdDevInDelta
+//	QuantileInRange ChiSquareFit
 //
 //	Len             NotLen
 //	Match           NotMatch
@@ -212,6 +284,12 @@
 //	Contains        NotContains
 //	SortEqual       NotSortEqual
 //	Subset          NotSubset
+//	Sorted          SortedFunc
+//	Increasing      NonIncreasing
+//	Decreasing      NonDecreasing
+//	Unique          NoDuplicates
+//	AllMatch        AnyMatch
+//	NoneMatch       CountMatch
 //
 //	HasType         NotHasType
 //	Implements      NotImplements
@@ -219,6 +297,18 @@
 //	FileExists      NotFileExists
 //	DirExists       NotDirExists
 //
+//	Receive         NotReceive
+//	Closed          NotClosed
+//	NoLeaks
+//
 //	Panic           NotPanic
 //	PanicMatch      PanicNotMatch
+//	PanicValue      PanicErrIs
+//
+// Matchers (to use inside expected value of [TB.DeepEqual]):
+//
+//	Any             NotZero            Re
+//	Gt              Ge                 Lt  Le
+//	InRange         Len
+//	AllOf           AnyOf              Not
 package check
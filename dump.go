@@ -22,6 +22,10 @@ var spewCfg = spew.ConfigState{
 	SpewKeys:                true,
 }
 
+// note is a text shown in a failure report as is, instead of a dump of some value -
+// e.g. to explain why there is no actual value to show.
+type note string
+
 type dump struct {
 	dump         string
 	indirectType reflect.Type
@@ -67,8 +71,14 @@ func (v dump) diff(expected dump) string {
 //
 // - []byte: same as string instead of hexdump for valid utf8
 // - []rune: use quoted char instead of number for valid runes in list
-// - [json.RawMessage]: indent, then same as string.
+// - [json.RawMessage]: indent, then same as string
+// - note: as is.
 func newDump(i any) (d dump) { //nolint:gocyclo,gocognit,funlen,cyclop // By design.
+	if v, ok := i.(note); ok {
+		d.dump = string(v) + "\n"
+		return d
+	}
+
 	d.dump = spewCfg.Sdump(i)
 
 	if i == nil {
@@ -160,3 +170,43 @@ func quote(s string) string {
 	}
 	return fmt.Sprintf("'%s'", string(q))
 }
+
+// explain returns a titled list of named dumps of values,
+// to be shown in a failure report under the dumps of checker's args.
+func explain(title string, name []string, values []any) string {
+	var buf strings.Builder
+	buf.WriteString(title + ":\n")
+	for i, v := range values {
+		dump := strings.TrimSuffix(newDump(v).String(), "\n")
+		fmt.Fprintf(&buf, "  %s: %s\n", name[i], strings.ReplaceAll(dump, "\n", "\n  "))
+	}
+	return buf.String()
+}
+
+// explainDiffs returns a titled list of named pairs of actual and expected values
+// with their dumps and diff, to be shown in a failure report under the dumps of checker's args.
+func explainDiffs(title string, name []string, actual, expected []any) string {
+	var buf strings.Builder
+	buf.WriteString(title + ":\n")
+	for i := range name {
+		dumpActual, dumpExpected := newDump(actual[i]), newDump(expected[i])
+		text := fmt.Sprintf("%-10s%s%-10s%s", nameExpected+":", dumpExpected, nameActual+":", dumpActual)
+		if diff := dumpActual.diff(dumpExpected); diff != "" {
+			text += colouredDiff(diff)
+		}
+		text = strings.TrimSuffix(text, "\n")
+		fmt.Fprintf(&buf, "  %s:\n    %s\n", name[i], strings.ReplaceAll(text, "\n", "\n    "))
+	}
+	return buf.String()
+}
+
+// explainText returns a titled free-form text (e.g. an explanation returned
+// by a custom checker), to be shown in a failure report under the dumps
+// of checker's args. It returns an empty string if text is empty.
+func explainText(title, text string) string {
+	text = strings.TrimRight(text, "\n")
+	if text == "" {
+		return ""
+	}
+	return title + ":\n  " + strings.ReplaceAll(text, "\n", "\n  ") + "\n"
+}
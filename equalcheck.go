@@ -9,7 +9,7 @@ import (
 //nolint:gochecknoglobals // Registry of custom equal checkers.
 var (
 	equalCheckersMu sync.RWMutex
-	equalCheckers   []EqualChecker
+	equalCheckers   []EqualExplainer
 )
 
 // EqualChecker compares two values for DeepEqual/NotDeepEqual.
@@ -17,6 +17,13 @@ var (
 // and the next registered checker (then the built-in logic) is consulted.
 type EqualChecker func(actual, expected any) (equal, ok bool)
 
+// EqualExplainer is like [EqualChecker], but it also may return
+// a human-readable explanation of a difference between actual and expected
+// (e.g. a list of different fields, one per line) which will be shown
+// in a failure report under the dumps.
+// Explanation is ignored if equal=true or ok=false.
+type EqualExplainer func(actual, expected any) (equal, ok bool, explanation string)
+
 // ResetEqualCheckers removes all registered equal checkers.
 //
 // Combine with RegisterEqualChecker to define a custom chain in a specific order.
@@ -36,35 +43,58 @@ func ResetEqualCheckers() {
 // Intended to be called from init() or TestMain.
 // Not safe to call concurrently with running checks.
 func RegisterEqualChecker(f EqualChecker) {
+	RegisterEqualExplainer(func(actual, expected any) (equal, ok bool, _ string) {
+		equal, ok = f(actual, expected)
+		return equal, ok, ""
+	})
+}
+
+// RegisterEqualExplainer is like [RegisterEqualChecker], but for
+// a checker which is able to explain a difference.
+//
+// Intended to be called from init() or TestMain.
+// Not safe to call concurrently with running checks.
+func RegisterEqualExplainer(f EqualExplainer) {
 	equalCheckersMu.Lock()
 	defer equalCheckersMu.Unlock()
 	equalCheckers = append(equalCheckers, f)
 }
 
 // runEqualCheckers iterates registered checkers with the original actual/expected values.
-// Returns (equal, ok) where ok=true means a checker claimed this pair.
-func runEqualCheckers(actual, expected any) (equal, ok bool) {
+// Returns (equal, ok, explanation) where ok=true means a checker claimed this pair.
+func runEqualCheckers(actual, expected any) (equal, ok bool, explanation string) {
 	equalCheckersMu.RLock()
 	defer equalCheckersMu.RUnlock()
 	for _, check := range equalCheckers {
-		if eq, claimed := check(actual, expected); claimed {
-			return eq, true
+		if eq, claimed, why := check(actual, expected); claimed {
+			if eq {
+				why = ""
+			}
+			return eq, true, why
 		}
 	}
-	return false, false
+	return false, false, ""
 }
 
-// elemEqual reports whether a and b are equal for element/value comparison
-// (used by SortEqual and Subset): registered EqualCheckers run first,
+// elemEqual reports whether actual and expected are equal for element/value
+// comparison (used by SortEqual and Subset): registered EqualCheckers run first,
 // falling back to [deepequal.DeepEqual], exactly like DeepEqual/NotDeepEqual do.
-func elemEqual(a, b any) bool {
-	equal, claimed := runEqualCheckers(a, b)
+// If matchers is true then expected may contain [Matcher] and they are
+// compared like DeepEqual does with matchers.
+//
+// Matchers should be found by hasMatcher once per checker call (in whole
+// expected collection), not for each compared element.
+func elemEqual(actual, expected any, matchers bool) bool {
+	if matchers {
+		return len(matchDeep(actual, expected)) == 0
+	}
+	equal, claimed, _ := runEqualCheckers(actual, expected)
 	if !claimed {
-		if hasMethod(a, "ProtoReflect") || hasMethod(b, "ProtoReflect") {
+		if hasMethod(actual, "ProtoReflect") || hasMethod(expected, "ProtoReflect") {
 			panic("check: protobuf message detected; " +
 				"import github.com/powerman/checkproto to compare protobuf messages")
 		}
-		equal = deepequal.DeepEqual(a, b)
+		equal = deepequal.DeepEqual(actual, expected)
 	}
 	return equal
 }
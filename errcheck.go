@@ -7,7 +7,7 @@ import (
 //nolint:gochecknoglobals // Registry of custom error checkers.
 var (
 	errCheckersMu sync.RWMutex
-	errCheckers   []ErrChecker
+	errCheckers   []ErrExplainer
 )
 
 // ErrChecker compares actual and expected errors.
@@ -15,8 +15,15 @@ var (
 // and the next registered checker (then the built-in logic) is consulted.
 type ErrChecker func(actual, expected error) (equal, ok bool)
 
+// ErrExplainer is like [ErrChecker], but it also may return
+// a human-readable explanation of a difference between actual and expected
+// (e.g. a list of different fields, one per line) which will be shown
+// in a failure report under the dumps.
+// Explanation is ignored if equal=true or ok=false.
+type ErrExplainer func(actual, expected error) (equal, ok bool, explanation string)
+
 // ResetErrCheckers removes all registered error checkers,
-// including the built-in CheckFieldError.
+// including the built-in ExplainFieldError.
 //
 // Combine with RegisterErrChecker to define a custom chain in a specific order.
 //
@@ -34,20 +41,35 @@ func ResetErrCheckers() {
 // Intended to be called from init() or TestMain.
 // Not safe to call concurrently with running checks.
 func RegisterErrChecker(f ErrChecker) {
+	RegisterErrExplainer(func(actual, expected error) (equal, ok bool, _ string) {
+		equal, ok = f(actual, expected)
+		return equal, ok, ""
+	})
+}
+
+// RegisterErrExplainer is like [RegisterErrChecker], but for
+// a checker which is able to explain a difference.
+//
+// Intended to be called from init() or TestMain.
+// Not safe to call concurrently with running checks.
+func RegisterErrExplainer(f ErrExplainer) {
 	errCheckersMu.Lock()
 	defer errCheckersMu.Unlock()
 	errCheckers = append(errCheckers, f)
 }
 
 // runCheckers iterates registered checkers with the original actual/expected errors.
-// Returns (equal, ok) where ok=true means a checker claimed this pair.
-func runCheckers(actual, expected error) (equal, ok bool) {
+// Returns (equal, ok, explanation) where ok=true means a checker claimed this pair.
+func runCheckers(actual, expected error) (equal, ok bool, explanation string) {
 	errCheckersMu.RLock()
 	defer errCheckersMu.RUnlock()
 	for _, check := range errCheckers {
-		if eq, claimed := check(actual, expected); claimed {
-			return eq, true
+		if eq, claimed, why := check(actual, expected); claimed {
+			if eq {
+				why = ""
+			}
+			return eq, true, why
 		}
 	}
-	return false, false
+	return false, false, ""
 }
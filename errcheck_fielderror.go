@@ -2,12 +2,15 @@ package check
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 )
 
-//nolint:gochecknoinits // Required for default CheckFieldError registration.
+//nolint:gochecknoinits // Required for default ExplainFieldError registration.
 func init() {
-	RegisterErrChecker(CheckFieldError)
+	RegisterErrExplainer(ExplainFieldError)
 }
 
 // fieldError is a duck-typed interface for errors that carry per-field
@@ -17,38 +20,162 @@ type fieldError interface {
 	Tag() string
 }
 
-type fieldErrKey struct {
-	ns, tag string
-}
+// FieldErrorKey is a part of validator.FieldError-like error used to compare
+// such errors by [FieldErrorChecker].
+type FieldErrorKey int
+
+// Parts of validator.FieldError-like error.
+const (
+	FieldErrorNamespace FieldErrorKey = iota + 1 // Namespace(), e.g. "User.Address.City".
+	FieldErrorField                              // Field(), e.g. "City".
+	FieldErrorTag                                // Tag(), e.g. "min".
+	FieldErrorParam                              // Param(), e.g. "3".
+	fieldErrorKeysLen
+)
+
+// fieldErrKey contains values of compared parts of a field error,
+// indexed by FieldErrorKey.
+type fieldErrKey [fieldErrorKeysLen]string
 
 // CheckFieldError compares two errors that (possibly after [errors.As]-style unwrapping)
 // are validator.FieldError-like values or slices of them,
 // by an unordered multiset of (Namespace, Tag) pairs — order does not matter.
 // Works with any type structurally providing Namespace()/Tag().
 //
-// Auto-registered on loading the package.
+// Its explaining variant [ExplainFieldError] is auto-registered
+// on loading the package.
 func CheckFieldError(actual, expected error) (equal, ok bool) {
+	equal, ok, _ = ExplainFieldError(actual, expected)
+	return equal, ok
+}
+
+// ExplainFieldError is like [CheckFieldError], but also explains
+// a difference: which (Namespace, Tag) pairs of expected are missing
+// from actual and which pairs of actual are unexpected.
+//
+// It's the same as [FieldErrorChecker](FieldErrorNamespace, FieldErrorTag).
+//
+// Auto-registered on loading the package.
+func ExplainFieldError(actual, expected error) (equal, ok bool, explanation string) {
+	return compareFieldErrors(actual, expected, defaultFieldErrorKeys)
+}
+
+var defaultFieldErrorKeys = []FieldErrorKey{FieldErrorNamespace, FieldErrorTag} //nolint:gochecknoglobals // Const.
+
+// FieldErrorChecker returns a checker like [ExplainFieldError], but
+// it compares errors by given parts instead of (Namespace, Tag).
+// Field errors without Field()/Param() method have an empty value
+// in these parts.
+//
+// To use it instead of the default one for all checks register it
+// after [ResetErrCheckers], or use [TB.FieldErrorKeys] for some checks.
+//
+// It panics if keys are empty or contain an unknown key.
+func FieldErrorChecker(keys ...FieldErrorKey) ErrExplainer {
+	keys = validFieldErrorKeys(keys)
+	return func(actual, expected error) (equal, ok bool, explanation string) {
+		return compareFieldErrors(actual, expected, keys)
+	}
+}
+
+func validFieldErrorKeys(keys []FieldErrorKey) []FieldErrorKey {
+	if len(keys) == 0 {
+		panic("field error keys are empty")
+	}
+	for _, key := range keys {
+		if key < FieldErrorNamespace || key >= fieldErrorKeysLen {
+			panic(fmt.Sprintf("unknown field error key: %d", key))
+		}
+	}
+	return slices.Clone(keys)
+}
+
+func compareFieldErrors(actual, expected error, keys []FieldErrorKey) (equal, ok bool, explanation string) {
 	actualFEs, ok1 := extractFieldErrors(actual)
 	expectedFEs, ok2 := extractFieldErrors(expected)
 	if !ok1 || !ok2 {
-		return false, false
-	}
-	if len(actualFEs) != len(expectedFEs) {
-		return false, true
+		return false, false, ""
 	}
 	counts := make(map[fieldErrKey]int, len(expectedFEs))
+	var order []fieldErrKey
 	for _, fe := range expectedFEs {
-		counts[fieldErrKey{fe.Namespace(), fe.Tag()}]++
+		key := fieldErrKeyOf(fe, keys)
+		if _, seen := counts[key]; !seen {
+			order = append(order, key)
+		}
+		counts[key]++
 	}
 	for _, fe := range actualFEs {
-		counts[fieldErrKey{fe.Namespace(), fe.Tag()}]--
+		key := fieldErrKeyOf(fe, keys)
+		if _, seen := counts[key]; !seen {
+			order = append(order, key)
+		}
+		counts[key]--
+	}
+	var buf strings.Builder
+	for _, key := range order {
+		switch c := counts[key]; {
+		case c > 0:
+			fmt.Fprintf(&buf, "missing field error: %s%s\n", key, times(c))
+		case c < 0:
+			fmt.Fprintf(&buf, "unexpected field error: %s%s\n", key, times(-c))
+		}
 	}
-	for _, c := range counts {
-		if c != 0 {
-			return false, true
+	if buf.Len() != 0 {
+		return false, true, strings.TrimSuffix(buf.String(), "\n")
+	}
+	return true, true, ""
+}
+
+func fieldErrKeyOf(fe fieldError, keys []FieldErrorKey) (key fieldErrKey) {
+	for _, k := range keys {
+		switch k {
+		case FieldErrorNamespace:
+			key[k] = fe.Namespace()
+		case FieldErrorField:
+			if x, ok := fe.(interface{ Field() string }); ok {
+				key[k] = x.Field()
+			}
+		case FieldErrorTag:
+			key[k] = fe.Tag()
+		case FieldErrorParam:
+			if x, ok := fe.(interface{ Param() string }); ok {
+				key[k] = x.Param()
+			}
 		}
 	}
-	return true, true
+	return key
+}
+
+// String returns compared parts of a field error in a form like
+// "User.Name (min=3)": Namespace and Field first, then Tag=Param in parens.
+func (key fieldErrKey) String() string {
+	var where, rule []string
+	for _, v := range []string{key[FieldErrorNamespace], key[FieldErrorField]} {
+		if v != "" {
+			where = append(where, v)
+		}
+	}
+	for _, v := range []string{key[FieldErrorTag], key[FieldErrorParam]} {
+		if v != "" {
+			rule = append(rule, v)
+		}
+	}
+	switch {
+	case len(rule) == 0:
+		return strings.Join(where, " ")
+	case len(where) == 0:
+		return "(" + strings.Join(rule, "=") + ")"
+	}
+	return strings.Join(where, " ") + " (" + strings.Join(rule, "=") + ")"
+}
+
+// times returns " ×n" for n > 1 or an empty string.
+func times(n int) string {
+	if n > 1 {
+		return fmt.Sprintf(" ×%d", n)
+	}
+	return ""
 }
 
 // extractFieldErrors walks the error tree looking for a value that
@@ -0,0 +1,218 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ErrContainsAll checks that each of expected errors is the same as
+// some error in actual's tree (like Err).
+//
+// It's useful for multi-errors (like returned by [errors.Join]),
+// but actual may be any error.
+// Empty expected is contained in any error (including nil).
+//
+// On failure it shows actual as a tree of wrapped errors and
+// which of expected errors were found and which are missing.
+func (t *checks) ErrContainsAll(actual error, expected []error, msg ...any) bool {
+	t.tb.Helper()
+	var found, missing []int
+	for i, err := range expected {
+		if t.isErr(actual, err) {
+			found = append(found, i)
+		} else {
+			missing = append(missing, i)
+		}
+	}
+	ok := len(missing) == 0
+	return t.reportExplained(ok, msg,
+		callerFuncName(0),
+		[]string{nameActual, nameExpected},
+		[]any{t.errArg(ok, actual, markNone), expected},
+		func() string {
+			return explainErrs("Found", expected, found) +
+				explainErrs("Missing", expected, missing)
+		})
+}
+
+// ErrExactly checks that actual consists of exactly expected errors,
+// in any order: each component of actual must be the same (like Err)
+// as a distinct expected error and vice versa.
+//
+// Components of actual are errors joined by multi-errors (Unwrap() []error,
+// e.g. [errors.Join] or [fmt.Errorf] with several %w), recursively,
+// also inside wrapped errors. Actual without multi-errors inside
+// is a single component, and nil actual has no components.
+//
+// On failure it shows actual as a tree of wrapped errors,
+// which of expected errors were found and which are missing,
+// and unexpected components of actual.
+func (t *checks) ErrExactly(actual error, expected []error, msg ...any) bool {
+	t.tb.Helper()
+	components := errComponents(actual)
+	used := make([]bool, len(components))
+	var found, missing []int
+	for i, err := range expected {
+		matched := false
+		for j, component := range components {
+			if !used[j] && t.isErr(component, err) {
+				used[j], matched = true, true
+				break
+			}
+		}
+		if matched {
+			found = append(found, i)
+		} else {
+			missing = append(missing, i)
+		}
+	}
+	var unexpected []int
+	for j := range used {
+		if !used[j] {
+			unexpected = append(unexpected, j)
+		}
+	}
+	ok := len(missing) == 0 && len(unexpected) == 0
+	return t.reportExplained(ok, msg,
+		callerFuncName(0),
+		[]string{nameActual, nameExpected},
+		[]any{t.errArg(ok, actual, markNone), expected},
+		func() string {
+			return explainErrs("Found", expected, found) +
+				explainErrs("Missing", expected, missing) +
+				explainErrs("Unexpected", components, unexpected)
+		})
+}
+
+// errComponents returns errors joined by multi-errors in err's tree.
+func errComponents(err error) []error {
+	if err == nil {
+		return nil
+	}
+	for e := err; e != nil; {
+		wrapped := unwrapOnce(e)
+		if _, isMulti := e.(interface{ Unwrap() []error }); isMulti { //nolint:errorlint // False positive.
+			var errs []error
+			for _, w := range wrapped {
+				errs = append(errs, errComponents(w)...)
+			}
+			return errs
+		}
+		e = nil
+		if len(wrapped) == 1 {
+			e = wrapped[0]
+		}
+	}
+	return []error{err}
+}
+
+// explainErrs returns a titled list of errs with given indices
+// (or an empty string if there are no indices).
+func explainErrs(title string, errs []error, indices []int) string {
+	if len(indices) == 0 {
+		return ""
+	}
+	var buf strings.Builder
+	buf.WriteString(title + ":\n")
+	for _, i := range indices {
+		text := "<nil>"
+		if errs[i] != nil {
+			text = fmt.Sprintf("%T: %s", errs[i], errs[i])
+		}
+		fmt.Fprintf(&buf, "  [%d]: %s\n", i, strings.ReplaceAll(text, "\n", "\n  "))
+	}
+	return buf.String()
+}
+
+// ErrOneOf checks that actual is the same (like Err) as one of expected errors.
+//
+// On failure it shows actual as a tree of wrapped errors
+// with errors of same type as one of expected marked.
+func (t *checks) ErrOneOf(actual error, expected []error, msg ...any) bool {
+	t.tb.Helper()
+	ok := slices.ContainsFunc(expected, func(err error) bool { return t.isErr(actual, err) })
+	return t.report2(t.errArg(ok, actual, func(err error) string {
+		for _, e := range expected {
+			if m := markSameType(e)(err); m != "" {
+				return m
+			}
+		}
+		return ""
+	}), expected, msg,
+		ok)
+}
+
+// ErrAsFunc checks that some error in actual's tree matches type T
+// (like in [errors.As]) and pred returns true for it.
+//
+// Pred must be a func(T) bool, where T is an error type or an interface.
+// It's called for every error in actual's tree matching T,
+// until it returns true.
+//
+// On failure it shows actual as a tree of wrapped errors
+// with errors matching T (for which pred returns false) marked.
+func (t *checks) ErrAsFunc(actual error, pred any, msg ...any) bool {
+	t.tb.Helper()
+	f := reflect.ValueOf(pred)
+	if f.Kind() != reflect.Func || f.Type().NumIn() != 1 || f.Type().NumOut() != 1 ||
+		f.Type().Out(0).Kind() != reflect.Bool ||
+		(f.Type().In(0).Kind() != reflect.Interface && !f.Type().In(0).Implements(typError)) {
+		panic("pred is not a func(T) bool with T being an error type or an interface")
+	}
+	typ := f.Type().In(0)
+	ok := false
+	for _, err := range errTree(actual) {
+		if v, matched := errAsType(err, typ); matched && f.Call([]reflect.Value{v})[0].Bool() {
+			ok = true
+			break
+		}
+	}
+	return t.reportExplained(ok, msg,
+		callerFuncName(0),
+		[]string{nameActual, "Func"},
+		[]any{t.errArg(ok, actual, func(err error) string {
+			if _, matched := errAsType(err, typ); matched {
+				return "matches " + typ.String()
+			}
+			return ""
+		}), note(funcName(pred) + "(" + typ.String() + ")")},
+		nil)
+}
+
+// errAsType returns err (without unwrapping) as a value of type typ
+// if it matches typ like in [errors.As].
+func errAsType(err error, typ reflect.Type) (reflect.Value, bool) {
+	if err == nil {
+		return reflect.Value{}, false
+	}
+	if reflect.TypeOf(err).AssignableTo(typ) {
+		v := reflect.New(typ).Elem()
+		v.Set(reflect.ValueOf(err))
+		return v, true
+	}
+	if x, ok := err.(interface{ As(target any) bool }); ok {
+		target := reflect.New(typ)
+		if x.As(target.Interface()) {
+			return target.Elem(), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// ErrMatch checks that [errors.Is](actual, expected) and actual's text
+// matches regex.
+//
+// Regex may be a *regexp.Regexp or string.
+//
+// On failure it shows actual as a tree of wrapped errors
+// with errors of same type as expected marked.
+func (t *checks) ErrMatch(actual, expected error, regex any, msg ...any) bool {
+	t.tb.Helper()
+	text := any(actual)
+	ok := isMatch(&text, regex) && errors.Is(actual, expected)
+	return t.report3(t.errArg(ok, actual, markSameType(expected)), expected, regex, msg,
+		ok)
+}
@@ -0,0 +1,152 @@
+package check_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+func TestErrContainsAll(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	joined := errors.Join(io.EOF, fmt.Errorf("wrapped: %w", &myError{"a"}), io.ErrUnexpectedEOF)
+
+	t.ErrContainsAll(joined, []error{io.EOF, &myError{"a"}})
+	t.ErrContainsAll(joined, []error{&myError{"a"}, io.ErrUnexpectedEOF, io.EOF})
+	t.ErrContainsAll(fmt.Errorf("ctx: %w", joined), []error{io.ErrUnexpectedEOF})
+	t.ErrContainsAll(io.EOF, []error{io.EOF})
+	t.ErrContainsAll(io.EOF, nil)
+	t.ErrContainsAll(nil, []error{})
+	t.ErrContainsAll(joined, []error{io.EOF, io.EOF})
+	todo.ErrContainsAll(joined, []error{io.EOF, &myError{"b"}})
+	todo.ErrContainsAll(nil, []error{io.EOF})
+	todo.ErrContainsAll(io.EOF, []error{io.EOF, io.ErrUnexpectedEOF})
+}
+
+func TestErrExactly(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	joined := errors.Join(io.EOF, fmt.Errorf("wrapped: %w", &myError{"a"}), io.ErrUnexpectedEOF)
+
+	t.ErrExactly(joined, []error{io.ErrUnexpectedEOF, &myError{"a"}, io.EOF})
+	t.ErrExactly(fmt.Errorf("ctx: %w", joined), []error{io.EOF, &myError{"a"}, io.ErrUnexpectedEOF})
+	t.ErrExactly(errors.Join(io.EOF, errors.Join(io.EOF, &myError{"a"})), []error{io.EOF, io.EOF, &myError{"a"}})
+	t.ErrExactly(fmt.Errorf("%w and %w", io.EOF, &myError{"a"}), []error{&myError{"a"}, io.EOF})
+	t.ErrExactly(fmt.Errorf("wrapped: %w", io.EOF), []error{io.EOF})
+	t.ErrExactly(nil, nil)
+	t.ErrExactly(nil, []error{})
+	todo.ErrExactly(joined, []error{io.EOF, &myError{"a"}})
+	todo.ErrExactly(joined, []error{io.EOF, &myError{"a"}, io.ErrUnexpectedEOF, io.EOF})
+	todo.ErrExactly(errors.Join(io.EOF, io.EOF), []error{io.EOF})
+	todo.ErrExactly(nil, []error{io.EOF})
+	todo.ErrExactly(io.EOF, nil)
+}
+
+func TestErrSetReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeErrSetReport"}
+	t := check.New(fake)
+	joined := errors.Join(io.EOF, &myError{"a"})
+
+	t.ErrContainsAll(joined, []error{io.EOF, io.ErrUnexpectedEOF})
+	t.ErrExactly(joined, []error{io.ErrClosedPipe, io.EOF})
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 2)
+	realT.Contains(fake.msgs[0], "Found:\n  [0]: *errors.errorString: EOF\n"+
+		"Missing:\n  [1]: *errors.errorString: unexpected EOF\n")
+	realT.Contains(fake.msgs[1], "Found:\n  [1]: *errors.errorString: EOF\n"+
+		"Missing:\n  [0]: *errors.errorString: io: read/write on closed pipe\n"+
+		"Unexpected:\n  [1]: *check_test.myError: a\n")
+}
+
+type codeError struct{ code int }
+
+func (e *codeError) Error() string { return fmt.Sprintf("code %d", e.code) }
+
+type asCodeError struct{}
+
+func (asCodeError) Error() string { return "as code" }
+
+func (asCodeError) As(target any) bool {
+	if p, ok := target.(**codeError); ok {
+		*p = &codeError{code: 42}
+		return true
+	}
+	return false
+}
+
+func TestErrOneOf(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.ErrOneOf(io.EOF, []error{io.ErrUnexpectedEOF, io.EOF})
+	t.ErrOneOf(fmt.Errorf("wrapped: %w", &myError{"a"}), []error{io.EOF, &myError{"a"}})
+	t.ErrOneOf(nil, []error{io.EOF, nil})
+	todo.ErrOneOf(io.EOF, []error{io.ErrUnexpectedEOF, &myError{"EOF"}})
+	todo.ErrOneOf(io.EOF, nil)
+	todo.ErrOneOf(nil, []error{io.EOF})
+}
+
+func TestErrAsFunc(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	err := errors.Join(&codeError{1}, fmt.Errorf("wrapped: %w", &codeError{2}))
+
+	t.ErrAsFunc(err, func(e *codeError) bool { return e.code == 1 })
+	t.ErrAsFunc(err, func(e *codeError) bool { return e.code == 2 })
+	t.ErrAsFunc(err, func(e interface{ Unwrap() error }) bool { return e.Unwrap() != nil })
+	t.ErrAsFunc(err, func(e error) bool { return e.Error() == "code 2" })
+	t.ErrAsFunc(asCodeError{}, func(e *codeError) bool { return e.code == 42 })
+	todo.ErrAsFunc(err, func(e *codeError) bool { return e.code == 3 })
+	todo.ErrAsFunc(err, func(*myError) bool { return true })
+	todo.ErrAsFunc(nil, func(error) bool { return true })
+
+	t.PanicMatch(func() { t.ErrAsFunc(err, 42) }, "pred is not a func")
+	t.PanicMatch(func() { t.ErrAsFunc(err, func(int) bool { return true }) }, "pred is not a func")
+	t.PanicMatch(func() { t.ErrAsFunc(err, func(*codeError) int { return 0 }) }, "pred is not a func")
+}
+
+func TestErrMatch(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	err := fmt.Errorf("read config.yml: %w", io.ErrUnexpectedEOF)
+
+	t.ErrMatch(err, io.ErrUnexpectedEOF, `^read .*\.yml:`)
+	t.ErrMatch(err, io.ErrUnexpectedEOF, regexp.MustCompile(`config`))
+	todo.ErrMatch(err, io.EOF, `config`)
+	todo.ErrMatch(err, io.ErrUnexpectedEOF, `^config`)
+	todo.ErrMatch(nil, nil, ``)
+
+	t.PanicMatch(func() { t.ErrMatch(err, io.EOF, 42) }, "regex is not a")
+}
+
+func TestErrRichReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeErrRichReport"}
+	t := check.New(fake)
+	err := fmt.Errorf("wrapped: %w", &codeError{1})
+
+	t.ErrOneOf(err, []error{io.EOF, &codeError{2}})
+	t.ErrAsFunc(err, func(e *codeError) bool { return e.code == 2 })
+	t.ErrMatch(err, io.EOF, `code`)
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 3)
+	realT.Contains(fake.msgs[0], `Actual:   *fmt.wrapError: "wrapped: code 1"`+"\n"+
+		`          └─ *check_test.codeError: "code 1"  ← same type as expected`+"\n")
+	realT.Match(fake.msgs[1], `Func:     TestErrRichReport\.func\d+\(\*check_test\.codeError\)\n`)
+	realT.Contains(fake.msgs[1], `└─ *check_test.codeError: "code 1"  ← matches *check_test.codeError`+"\n")
+	realT.Contains(fake.msgs[2], "Regex:    (string) (len=4) 'code'\n")
+	realT.Contains(fake.msgs[2], "Expected: ")
+	realT.Contains(fake.msgs[2], `Actual:   *fmt.wrapError: "wrapped: code 1"`+"\n")
+}
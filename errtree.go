@@ -0,0 +1,110 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// errTreeNote returns err as a tree of wrapped errors to be shown in
+// a failure report instead of a dump of err: one line per error with its
+// type and text, with a mark returned by mark (if it's not empty).
+// It returns nil if err is nil.
+func errTreeNote(err error, mark func(error) string) any {
+	if err == nil {
+		return nil
+	}
+	var buf strings.Builder
+	var walk func(err error, prefix, childPrefix string)
+	walk = func(err error, prefix, childPrefix string) {
+		fmt.Fprintf(&buf, "%s%T: %s", prefix, err, errText(err))
+		if m := mark(err); m != "" {
+			fmt.Fprintf(&buf, "  ← %s", m)
+		}
+		buf.WriteString("\n")
+		var wrapped []error
+		func() {
+			defer func() { _ = recover() }()
+			for _, w := range unwrapOnce(err) {
+				if w != nil {
+					wrapped = append(wrapped, w)
+				}
+			}
+		}()
+		for i, w := range wrapped {
+			if i == len(wrapped)-1 {
+				walk(w, childPrefix+"└─ ", childPrefix+"   ")
+			} else {
+				walk(w, childPrefix+"├─ ", childPrefix+"│  ")
+			}
+		}
+	}
+	walk(err, "", strings.Repeat(" ", len(nameActual+":   ")))
+	return note(strings.TrimSuffix(buf.String(), "\n"))
+}
+
+// errText returns quoted err.Error() or description of a panic in Error().
+func errText(err error) (text string) {
+	defer func() {
+		if p := recover(); p != nil {
+			text = fmt.Sprintf("<Error() panics: %v>", p)
+		}
+	}()
+	return fmt.Sprintf("%q", err.Error())
+}
+
+// markSameType marks errors with same type as expected.
+func markSameType(expected error) func(error) string {
+	return func(err error) string {
+		if expected != nil && reflect.TypeOf(err) == reflect.TypeOf(expected) {
+			return "same type as expected"
+		}
+		return ""
+	}
+}
+
+// markMatched marks errors for which match returns true.
+func markMatched(match func(error) bool) func(error) string {
+	return func(err error) string {
+		if match(err) {
+			return "matched"
+		}
+		return ""
+	}
+}
+
+// markNone doesn't mark any errors.
+func markNone(error) string { return "" }
+
+// isErrIdentical checks is err (without unwrapping) matches target
+// like in [errors.Is].
+func isErrIdentical(err, target error) bool {
+	if reflect.TypeOf(err).Comparable() && err == target { //nolint:errorlint // Without unwrapping.
+		return true
+	}
+	x, ok := err.(interface{ Is(target error) bool })
+	return ok && x.Is(target)
+}
+
+// isErrAssignable checks is err (without unwrapping) matches target
+// like in [errors.As].
+func isErrAssignable(err error, target any) bool {
+	typ := reflect.TypeOf(target)
+	if typ == nil || typ.Kind() != reflect.Pointer {
+		return false
+	}
+	if reflect.TypeOf(err).AssignableTo(typ.Elem()) {
+		return true
+	}
+	x, ok := err.(interface{ As(target any) bool })
+	return ok && x.As(reflect.New(typ.Elem()).Interface())
+}
+
+// errArg returns err to be reported by a checker: if check is going
+// to fail it's errTreeNote(err, mark), or else err as is.
+func (c *checks) errArg(ok bool, err error, mark func(error) string) any {
+	if ok != c.todo {
+		return err
+	}
+	return errTreeNote(err, mark)
+}
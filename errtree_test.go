@@ -0,0 +1,47 @@
+package check_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+func TestErrTreeReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeErrTreeReport"}
+	t := check.New(fake)
+	err := fmt.Errorf("ctx: %w", errors.Join(errors.New("a"), fmt.Errorf("b: %w", io.EOF)))
+
+	t.Err(err, errors.New("c"))
+	t.NotErr(err, io.EOF)
+	t.ErrIs(err, io.ErrClosedPipe)
+	t.NotErrIs(err, io.EOF)
+	var pathErr *os.PathError
+	t.ErrAs(err, &pathErr)
+	t.Err(pkgerrorsWrap(io.EOF, "wrapped"), io.ErrUnexpectedEOF)
+	t.Err(nil, io.EOF)
+	t.TODO().Err(err, io.EOF)
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 8)
+	tree := `Actual:   *fmt.wrapError: "ctx: a\nb: EOF"
+          └─ *errors.joinError: "a\nb: EOF"
+             ├─ *errors.errorString: "a"%s
+             └─ *fmt.wrapError: "b: EOF"
+                └─ *errors.errorString: "EOF"%s
+`
+	realT.Contains(fake.msgs[0], fmt.Sprintf(tree, "  ← same type as expected", "  ← same type as expected"))
+	realT.Contains(fake.msgs[1], fmt.Sprintf(tree, "", "  ← matched"))
+	realT.Contains(fake.msgs[2], fmt.Sprintf(tree, "  ← same type as expected", "  ← same type as expected"))
+	realT.Contains(fake.msgs[3], fmt.Sprintf(tree, "", "  ← matched"))
+	realT.Contains(fake.msgs[4], fmt.Sprintf(tree, "", ""))
+	realT.Contains(fake.msgs[5], `Actual:   *check_test.causeError: "wrapped"`+"\n"+
+		`          └─ *errors.errorString: "EOF"  ← same type as expected`+"\n")
+	realT.Contains(fake.msgs[6], "Actual:   <nil>\n")
+	realT.Contains(fake.msgs[7], "Checker:  TODO Err\n")
+	realT.Contains(fake.msgs[7], `Actual:   *fmt.wrapError: "ctx: a\nb: EOF"`)
+}
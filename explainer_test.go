@@ -0,0 +1,160 @@
+package check_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+// Deliberately not parallel: it mutates the process-global equal checker registry.
+//
+//nolint:paralleltest // Modifies global registry, cannot run in parallel.
+func TestEqualExplainer(tt *testing.T) {
+	t := check.T(tt)
+	t.Cleanup(check.ResetEqualCheckers)
+
+	type point struct{ X, Y int }
+	check.RegisterEqualExplainer(func(actual, expected any) (equal, ok bool, explanation string) {
+		a, okA := actual.(point)
+		e, okE := expected.(point)
+		if !okA || !okE {
+			return false, false, ""
+		}
+		if a.X != e.X {
+			explanation += fmt.Sprintf("X: %d != %d\n", a.X, e.X)
+		}
+		if a.Y != e.Y {
+			explanation += fmt.Sprintf("Y: %d != %d\n", a.Y, e.Y)
+		}
+		return explanation == "", true, explanation
+	})
+
+	t.DeepEqual(point{1, 2}, point{1, 2})
+	t.NotDeepEqual(point{1, 2}, point{1, 3})
+	t.TODO().DeepEqual(point{1, 2}, point{3, 4})
+
+	fake := &fakeTB{name: "fakeEqualExplainer"}
+	c := check.New(fake)
+	c.DeepEqual(point{1, 2}, point{3, 4})
+	c.DeepEqual(point{1, 2}, point{1, 3})
+	c.DeepEqual(1, 2)
+	t.Equal(len(fake.msgs), 3)
+	t.Contains(fake.msgs[0], "Explanation:\n  X: 1 != 3\n  Y: 2 != 4\n")
+	t.Contains(fake.msgs[1], "Explanation:\n  Y: 2 != 3\n")
+	t.NotContains(fake.msgs[2], "Explanation:")
+}
+
+func TestErrExplainer(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+
+	fake := &fakeTB{name: "fakeErrExplainer"}
+	c := check.New(fake)
+	actual := fmt.Errorf("validate: %w", fieldErrors{
+		{ns: "User.Name", tag: "required"},
+		{ns: "User.Email", tag: "email"},
+	})
+	c.Err(actual, fieldErrors{
+		{ns: "User.Name", tag: "required"},
+		{ns: "User.Age", tag: "min"},
+		{ns: "User.Age", tag: "min"},
+	})
+	c.Err(actual, fieldErrors{
+		{ns: "User.Email", tag: "email"},
+		{ns: "User.Name", tag: "required"},
+	})
+	c.Err(&fieldError{ns: "ns", tag: "tag"}, &fieldError{ns: "ns", tag: "other"})
+	t.Equal(len(fake.msgs), 2)
+	t.Contains(fake.msgs[0], "Explanation:\n"+
+		"  *fmt.wrapError: missing field error: User.Age (min) ×2\n"+
+		"    unexpected field error: User.Email (email)\n")
+	t.NotContains(fake.msgs[0], "check_test.fieldErrors: missing")
+	t.Contains(fake.msgs[1], "Explanation:\n"+
+		"  *check_test.fieldError: missing field error: ns (other)\n"+
+		"    unexpected field error: ns (tag)\n")
+
+	equal, ok, explanation := check.ExplainFieldError(&fieldError{ns: "ns", tag: "tag"}, &fieldError{ns: "ns", tag: "tag"})
+	t.True(equal)
+	t.True(ok)
+	t.Zero(explanation)
+	equal, ok = check.CheckFieldError(&fieldError{ns: "ns", tag: "tag"}, &fieldError{ns: "ns", tag: "other"})
+	t.False(equal)
+	t.True(ok)
+}
+
+type paramFieldError struct {
+	fieldError
+	field, param string
+}
+
+func (e *paramFieldError) Field() string { return e.field }
+func (e *paramFieldError) Param() string { return e.param }
+
+type paramFieldErrors []*paramFieldError
+
+func (paramFieldErrors) Error() string { return "field errors" }
+
+func TestFieldErrorKeys(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+
+	pfe := func(ns, field, tag, param string) *paramFieldError {
+		return &paramFieldError{fieldError: fieldError{ns: ns, tag: tag}, field: field, param: param}
+	}
+	actual := paramFieldErrors{
+		pfe("User.Name", "Name", "min", "3"),
+		pfe("User.Address.City", "City", "required", ""),
+	}
+
+	t.Err(actual, paramFieldErrors{
+		pfe("User.Name", "Name", "min", "5"),
+		pfe("User.Address.City", "City", "required", ""),
+	})
+	byParam := t.FieldErrorKeys(check.FieldErrorNamespace, check.FieldErrorTag, check.FieldErrorParam)
+	byParam.NotErr(actual, paramFieldErrors{
+		pfe("User.Name", "Name", "min", "5"),
+		pfe("User.Address.City", "City", "required", ""),
+	})
+	byParam.Err(actual, paramFieldErrors{
+		pfe("User.Address.City", "City", "required", ""),
+		pfe("User.Name", "Name", "min", "3"),
+	})
+	byField := t.FieldErrorKeys(check.FieldErrorField, check.FieldErrorTag)
+	byField.Err(actual, paramFieldErrors{
+		pfe("Name", "Name", "min", ""),
+		pfe("City", "City", "required", ""),
+	})
+	byField.ErrExactly(fmt.Errorf("ctx: %w", actual), []error{paramFieldErrors{
+		pfe("Name", "Name", "min", ""),
+		pfe("City", "City", "required", ""),
+	}})
+	t.NotErr(actual, paramFieldErrors{
+		pfe("Name", "Name", "min", ""),
+		pfe("City", "City", "required", ""),
+	})
+	// Field errors without Field()/Param() have empty values in these parts.
+	byField.Err(&fieldError{ns: "User.Name", tag: "min"}, &fieldError{ns: "Other", tag: "min"})
+
+	t.PanicMatch(func() { t.FieldErrorKeys() }, `keys are empty`)
+	t.PanicMatch(func() { t.FieldErrorKeys(check.FieldErrorKey(42)) }, `unknown field error key: 42`)
+	t.PanicMatch(func() { check.FieldErrorChecker() }, `keys are empty`)
+
+	fake := &fakeTB{name: "fakeFieldErrorKeys"}
+	c := check.New(fake).FieldErrorKeys(check.FieldErrorNamespace, check.FieldErrorField,
+		check.FieldErrorTag, check.FieldErrorParam)
+	c.Err(actual, paramFieldErrors{
+		pfe("User.Name", "Name", "min", "5"),
+		pfe("User.Address.City", "City", "required", ""),
+	})
+	t.Equal(len(fake.msgs), 1)
+	t.Contains(fake.msgs[0], "Explanation:\n"+
+		"  check_test.paramFieldErrors: missing field error: User.Name Name (min=5)\n"+
+		"    unexpected field error: User.Name Name (min=3)\n")
+
+	checker := check.FieldErrorChecker(check.FieldErrorField)
+	equal, ok, explanation := checker(actual, paramFieldErrors{pfe("X", "Name", "", ""), pfe("Y", "Town", "", "")})
+	t.False(equal)
+	t.True(ok)
+	t.Equal(explanation, "missing field error: Town\nunexpected field error: City")
+}
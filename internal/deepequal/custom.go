@@ -65,3 +65,17 @@ func equalFunc(v reflect.Value) (equal reflect.Value, ok bool) {
 		typ.NumOut() == 1 && typ.Out(0) == boolType
 	return equal, ok
 }
+
+// Interface returns v's current value as an any, like [reflect.Value.Interface],
+// but also works for values obtained by accessing unexported struct fields.
+func Interface(v reflect.Value) any {
+	return valueInterface(v)
+}
+
+// Settable returns v which can be set (if it's addressable) and used
+// to set other values even if it was obtained by accessing unexported
+// struct fields.
+func Settable(v reflect.Value) reflect.Value {
+	forceExported(&v)
+	return v
+}
@@ -0,0 +1,148 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// iterMaxLen limits amount of elements drained from an iterator by
+// checkers which accept iterators. Checker panics if iterator yields more
+// elements (e.g. it's infinite).
+const iterMaxLen = 100_000
+
+// iterKind returns 1 for iter.Seq[T], 2 for iter.Seq2[K,V] and 0 otherwise.
+func iterKind(typ reflect.Type) int {
+	if typ == nil || typ.Kind() != reflect.Func || typ.NumIn() != 1 || typ.NumOut() != 0 {
+		return 0
+	}
+	yield := typ.In(0)
+	if yield.Kind() != reflect.Func || yield.NumOut() != 1 || yield.Out(0).Kind() != reflect.Bool {
+		return 0
+	}
+	if n := yield.NumIn(); n == 1 || n == 2 {
+		return n
+	}
+	return 0
+}
+
+// iterOf returns v as an iterator: either v itself or result of v.All().
+// It returns invalid value if v isn't an iterator and has no such method.
+// All isn't called on nil pointer receiver.
+func iterOf(v any) reflect.Value {
+	val := reflect.ValueOf(v)
+	if !val.IsValid() {
+		return reflect.Value{}
+	}
+	if iterKind(val.Type()) != 0 {
+		return val
+	}
+	switch val.Kind() { //nolint:exhaustive // Only these kinds are collections or may be nil.
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String:
+		return reflect.Value{} // Do not change meaning of collections with All method.
+	case reflect.Pointer:
+		if val.IsNil() {
+			return reflect.Value{}
+		}
+	}
+	all := val.MethodByName("All")
+	if !all.IsValid() || all.Type().NumIn() != 0 || all.Type().NumOut() != 1 || iterKind(all.Type().Out(0)) == 0 {
+		return reflect.Value{}
+	}
+	return all.Call(nil)[0]
+}
+
+// iterPair is a type of elements drained from iter.Seq2[K,V].
+func iterPair(seq reflect.Type) reflect.Type {
+	yield := seq.In(0)
+	return reflect.StructOf([]reflect.StructField{
+		{Name: "Key", Type: yield.In(0)},
+		{Name: "Value", Type: yield.In(1)},
+	})
+}
+
+// rangeIter calls f for every element yielded by iterator seq
+// (for iter.Seq[T] key is invalid).
+// It panics if seq yields more than iterMaxLen elements.
+func rangeIter(seq reflect.Value, f func(key, value reflect.Value)) {
+	if seq.IsNil() {
+		return
+	}
+	n := 0
+	typYield := seq.Type().In(0)
+	yield := reflect.MakeFunc(typYield, func(args []reflect.Value) []reflect.Value {
+		n++
+		if n > iterMaxLen {
+			return []reflect.Value{reflect.ValueOf(false).Convert(typYield.Out(0))}
+		}
+		if len(args) == 1 {
+			f(reflect.Value{}, args[0])
+		} else {
+			f(args[0], args[1])
+		}
+		return []reflect.Value{reflect.ValueOf(true).Convert(typYield.Out(0))}
+	})
+	seq.Call([]reflect.Value{yield})
+	if n > iterMaxLen {
+		panic(fmt.Sprintf("iterator yields more than %d elements", iterMaxLen))
+	}
+}
+
+// drainIter returns v as is unless it's an iterator (see iterOf),
+// otherwise it returns a slice with all yielded elements:
+// []T for iter.Seq[T] and []struct{Key K; Value V} for iter.Seq2[K,V].
+// Nil iterator is drained into nil slice.
+func drainIter(v any) any {
+	seq := iterOf(v)
+	if !seq.IsValid() {
+		return v
+	}
+	var elem reflect.Type
+	if iterKind(seq.Type()) == 1 {
+		elem = seq.Type().In(0).In(0)
+	} else {
+		elem = iterPair(seq.Type())
+	}
+	elems := reflect.Zero(reflect.SliceOf(elem))
+	rangeIter(seq, func(key, value reflect.Value) {
+		if key.IsValid() {
+			pair := reflect.New(elem).Elem()
+			pair.Field(0).Set(key)
+			pair.Field(1).Set(value)
+			value = pair
+		}
+		elems = reflect.Append(elems, value)
+	})
+	return elems.Interface()
+}
+
+// drainSeq is like drainIter but drains only iter.Seq[T] and
+// iter.Seq2[K,V] func values, without calling All method.
+func drainSeq(v any) any {
+	if iterKind(reflect.TypeOf(v)) == 0 {
+		return v
+	}
+	return drainIter(v)
+}
+
+// drainIterValues is like drainIter but for iter.Seq2[K,V] it returns []V.
+func drainIterValues(v any) any {
+	seq := iterOf(v)
+	if !seq.IsValid() || iterKind(seq.Type()) != 2 {
+		return drainIter(v)
+	}
+	elems := reflect.Zero(reflect.SliceOf(seq.Type().In(0).In(1)))
+	rangeIter(seq, func(_, value reflect.Value) {
+		elems = reflect.Append(elems, value)
+	})
+	return elems.Interface()
+}
+
+// explainDrained shows elements drained from actual if it was an iterator.
+func explainDrained(actual, drained any) func() string {
+	return func() string {
+		if !iterOf(actual).IsValid() {
+			return ""
+		}
+		return explain("Drained", []string{nameActual}, []any{drained})
+	}
+}
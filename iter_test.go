@@ -0,0 +1,167 @@
+package check_test
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+type intSet struct{ items map[int]struct{} }
+
+func newIntSet(items ...int) intSet {
+	s := intSet{items: make(map[int]struct{})}
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+	return s
+}
+
+func (s intSet) All() iter.Seq[int] { return maps.Keys(s.items) }
+
+type intList struct{ items []int }
+
+func (l intList) All() iter.Seq2[int, int] { return slices.All(l.items) }
+
+// ptrSet has All method which can't be called on nil receiver.
+type ptrSet struct{ items []int }
+
+func (s *ptrSet) All() iter.Seq[int] { return slices.Values(s.items) }
+
+// emptyAll has All method which yields nothing.
+type emptyAll struct{ X int }
+
+func (emptyAll) All() iter.Seq[int] { return func(func(int) bool) {} }
+
+func naturals(yield func(int) bool) {
+	for i := 1; yield(i); i++ {
+	}
+}
+
+func TestIterLen(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.Len(slices.Values([]int{1, 2, 3}), 3)
+	t.Len(maps.All(map[string]int{"a": 1, "b": 2}), 2)
+	t.Len(iter.Seq[int](nil), 0)
+	t.Len(intList{[]int{1, 2}}, 2)
+	t.Len(strings.SplitSeq("a,b,c", ","), 3)
+	todo.Len(slices.Values([]int{1, 2, 3}), 2)
+	t.NotLen(slices.Values([]int{1, 2, 3}), 2)
+	todo.NotLen(slices.Values([]int{1, 2, 3}), 3)
+	t.PanicMatch(func() { t.Len(iter.Seq[int](naturals), 0) }, `iterator yields more than \d+ elements`)
+}
+
+func TestIterZero(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.Zero(iter.Seq[int](nil))
+	t.Zero(slices.Values([]int{}))
+	t.Zero(maps.All(map[int]int{}))
+	t.Zero(newIntSet().All())
+	todo.Zero(slices.Values([]int{0}))
+	t.NotZero(slices.Values([]int{0}))
+	t.NotZero(intList{[]int{0}}.All())
+	todo.NotZero(slices.Values([]int(nil)))
+}
+
+func TestIterContains(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.Contains(slices.Values([]int{1, 2, 3}), 2)
+	t.Contains(maps.All(map[string]int{"a": 1, "b": 2}), 2)
+	t.Contains(newIntSet(1, 2), 2)
+	t.Contains(intList{[]int{1, 2}}, 2)
+	todo.Contains(slices.Values([]int{1, 2, 3}), 4)
+	t.NotContains(slices.Values([]int{1, 2, 3}), 4)
+	t.NotContains(slices.Values([]int(nil)), 4)
+	todo.NotContains(maps.All(map[string]int{"a": 1}), 1)
+	t.PanicMatch(func() { t.Contains(slices.Values([]int{1}), "1") }, "expected type not match actual element type")
+}
+
+func TestIterSortEqualSubset(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.SortEqual(slices.Values([]int{1, 2, 3}), []int{3, 1, 2})
+	t.SortEqual([]int{3, 1, 2}, slices.Values([]int{1, 2, 3}))
+	t.SortEqual(newIntSet(1, 2), []int{2, 1})
+	t.SortEqual(maps.All(map[string]int{"a": 1, "b": 2}), maps.All(map[string]int{"b": 2, "a": 1}))
+	t.SortEqual(slices.Values([]int(nil)), []int{})
+	todo.SortEqual(slices.Values([]int{1, 2}), []int{1, 2, 2})
+	todo.SortEqual(maps.All(map[string]int{"a": 1}), maps.All(map[string]int{"a": 2}))
+	t.NotSortEqual(slices.Values([]int{1, 2}), []int{1, 2, 2})
+
+	t.Subset(slices.Values([]int{1, 2, 3}), []int{3, 1})
+	t.Subset([]int{3, 1, 2}, slices.Values([]int{1, 2}))
+	t.Subset(maps.All(map[string]int{"a": 1, "b": 2}), maps.All(map[string]int{"b": 2}))
+	todo.Subset(slices.Values([]int{1, 2}), []int{1, 3})
+	t.NotSubset(slices.Values([]int{1, 2}), []int{1, 3})
+	todo.NotSubset(newIntSet(1, 2), []int{2})
+}
+
+func TestIterMatch(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.AllMatch(slices.Values([]int{1, 2}), isPositive)
+	t.AnyMatch(maps.All(map[string]int{"a": -1, "b": 2}), isPositive)
+	t.CountMatch(intList{[]int{-1, 2, 3}}, isPositive, 2)
+	todo.NoneMatch(slices.Values([]int{-1, 2}), isPositive)
+}
+
+func TestIterReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeIterReport"}
+	t := check.New(fake)
+
+	t.Len(slices.Values([]int{1, 2, 3}), 2)
+	t.Contains(slices.Values([]int{1, 2}), 3)
+	t.AllMatch(maps.All(map[string]int{"a": 1, "b": -2}), isPositive)
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 3)
+	realT.Contains(fake.msgs[0], "Drained:\n  Actual: ([]int) (len=3) {\n")
+	realT.Contains(fake.msgs[1], "Actual:   ([]int) (len=2) {\n")
+	realT.Contains(fake.msgs[2], "Not matching:\n  [\"b\"]: (int) -2\n")
+}
+
+// Values with All method are drained by collection checkers,
+// but Zero/NotZero check them as is.
+func TestIterAllMethod(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.Len(&ptrSet{[]int{1, 2}}, 2)
+	t.Len(emptyAll{X: 5}, 0)
+	todo.Len(newIntSet(1, 2), 1)
+	t.Contains(&ptrSet{[]int{1, 2}}, 2)
+	todo.Contains(intList{[]int{1, 2}}, 3)
+	t.SortEqual(&ptrSet{[]int{2, 1}}, newIntSet(1, 2))
+	t.SortEqual(intList{[]int{5, 6}}, maps.All(map[int]int{0: 5, 1: 6}))
+	todo.SortEqual(emptyAll{}, []int{1})
+	t.Subset(newIntSet(1, 2, 3), &ptrSet{[]int{3, 1}})
+	t.Subset(intList{[]int{5, 6}}, maps.All(map[int]int{1: 6}))
+	todo.Subset(emptyAll{}, []int{1})
+
+	// All isn't called on nil pointer receiver.
+	t.Zero((*ptrSet)(nil))
+	t.PanicNotMatch(func() { t.Len((*ptrSet)(nil), 0) }, `nil pointer dereference`) // Not a collection.
+	t.NotZero(&ptrSet{})
+	t.NotZero(emptyAll{X: 5})
+	todo.Zero(emptyAll{X: 5})
+	t.Zero(emptyAll{})
+	t.Zero((&ptrSet{}).All())
+}
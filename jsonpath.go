@@ -0,0 +1,425 @@
+package check
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/powerman/check/internal/deepequal"
+)
+
+// JSONPath checks that value found at path in JSON document actual
+// is equal to expected (after converting expected to JSON using [json.Marshal]).
+//
+// Actual may have same types as in JSONEqual.
+//
+// Path may be either a JSON Pointer (RFC 6901, e.g. "/items/0/id")
+// or a subset of JSONPath selecting a single value:
+// root "$" followed by any amount of ".name", "['name']" and "[index]"
+// (e.g. "$.items[0].id"). It panics on invalid or unsupported path.
+//
+// In case actual is nil or empty or is invalid JSON or there is no value at path - check will fail.
+func (t *checks) JSONPath(actual any, path string, expected any, msg ...any) bool {
+	t.tb.Helper()
+	keys := parseJSONPath(path)
+	buf, err := json.Marshal(expected)
+	if err != nil {
+		panic("expected can't be converted to JSON: " + err.Error())
+	}
+	var ok bool
+	doc, valid := jsonDecode(actual, t.useNumber)
+	if valid {
+		v, found, missing := jsonLookup(doc, keys)
+		if found {
+			want, _ := jsonDecode(buf, t.useNumber)
+			ok = deepequal.DeepEqual(v, want)
+			actual = jsonRaw(v)
+		} else {
+			actual = note(missing)
+		}
+		expected = json.RawMessage(buf)
+	}
+	return t.reportExplained(ok, msg,
+		callerFuncName(0),
+		[]string{nameActual, nameExpected},
+		[]any{actual, expected},
+		func() string { return fmt.Sprintf("%-10s%s\n", "Path:", path) })
+}
+
+// JSONSubset checks that JSON document actual contains everything
+// from JSON document expected: every key/value in expected object
+// must exist in actual object (which may have extra keys),
+// arrays must have same length and are compared by position,
+// other values must be equal.
+// Comparison is recursive, so objects nested in arrays are compared
+// as subsets too.
+//
+// Both actual and expected may have same types as in JSONEqual.
+//
+// In case any of actual or expected is nil or empty or is invalid JSON - check will fail.
+// On failure it shows JSON paths of all mismatches.
+func (t *checks) JSONSubset(actual, expected any, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportJSONSubset(actual, expected, msg, false)
+}
+
+// JSONSubsetUnordered is like JSONSubset, but compares arrays as multisets:
+// every element of expected array must match (as a subset) a separate element
+// of actual array, in any order, and actual array may have extra elements.
+func (t *checks) JSONSubsetUnordered(actual, expected any, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportJSONSubset(actual, expected, msg, true)
+}
+
+func (c *checks) reportJSONSubset(actual, expected any, msg []any, unordered bool) bool {
+	c.tb.Helper()
+	var mismatches []string
+	docActual, okActual := jsonDecode(actual, c.useNumber)
+	docExpected, okExpected := jsonDecode(expected, c.useNumber)
+	if okActual && okExpected {
+		mismatches = jsonSubset(docActual, docExpected, "$", unordered)
+	}
+	if okActual {
+		actual = jsonRaw(docActual)
+	}
+	if okExpected {
+		expected = jsonRaw(docExpected)
+	}
+	ok := okActual && okExpected && len(mismatches) == 0
+	return c.reportExplained(ok, msg,
+		callerFuncName(1),
+		[]string{nameActual, nameExpected},
+		[]any{actual, expected},
+		func() string {
+			if len(mismatches) == 0 {
+				return ""
+			}
+			return "Mismatches:\n  " + strings.Join(mismatches, "\n  ") + "\n"
+		})
+}
+
+// jsonSubset returns descriptions of all places where expected is not a subset of actual.
+func jsonSubset(actual, expected any, path string, unordered bool) []string {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return []string{path + ": expected object, got " + jsonKind(actual)}
+		}
+		var mismatches []string
+		for _, k := range slices.Sorted(maps.Keys(e)) {
+			av, found := a[k]
+			if !found {
+				mismatches = append(mismatches, jsonPathKey(path, k)+": missing")
+				continue
+			}
+			mismatches = append(mismatches, jsonSubset(av, e[k], jsonPathKey(path, k), unordered)...)
+		}
+		return mismatches
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			return []string{path + ": expected array, got " + jsonKind(actual)}
+		}
+		if unordered {
+			return jsonSubsetUnordered(a, e, path)
+		}
+		if len(a) != len(e) {
+			return []string{fmt.Sprintf("%s: expected %d elements, got %d", path, len(e), len(a))}
+		}
+		var mismatches []string
+		for i := range e {
+			mismatches = append(mismatches, jsonSubset(a[i], e[i], jsonPathIndex(path, i), unordered)...)
+		}
+		return mismatches
+	default:
+		if !deepequal.DeepEqual(actual, expected) {
+			return []string{fmt.Sprintf("%s: expected %s, got %s", path, jsonRaw(expected), jsonRaw(actual))}
+		}
+		return nil
+	}
+}
+
+// jsonSubsetUnordered finds a separate matching element of actual
+// for each element of expected (using augmenting paths for bipartite matching).
+func jsonSubsetUnordered(actual, expected []any, path string) []string {
+	matches := make([][]int, len(expected))
+	for i := range expected {
+		for j := range actual {
+			if len(jsonSubset(actual[j], expected[i], "", true)) == 0 {
+				matches[i] = append(matches[i], j)
+			}
+		}
+	}
+	owner := make([]int, len(actual))
+	for j := range owner {
+		owner[j] = -1
+	}
+	var assign func(i int, seen []bool) bool
+	assign = func(i int, seen []bool) bool {
+		for _, j := range matches[i] {
+			if seen[j] {
+				continue
+			}
+			seen[j] = true
+			if owner[j] == -1 || assign(owner[j], seen) {
+				owner[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	var mismatches []string
+	for i := range expected {
+		if !assign(i, make([]bool, len(actual))) {
+			mismatches = append(mismatches, fmt.Sprintf("%s: no match for expected element %s",
+				jsonPathIndex(path, i), jsonRaw(expected[i])))
+		}
+	}
+	return mismatches
+}
+
+// jsonDiff returns descriptions of all places where actual differs from expected.
+func jsonDiff(actual, expected any, path string) []string {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			break
+		}
+		var differences []string
+		for _, k := range slices.Sorted(maps.Keys(e)) {
+			if _, found := a[k]; !found {
+				differences = append(differences, jsonPathKey(path, k)+": missing")
+			} else {
+				differences = append(differences, jsonDiff(a[k], e[k], jsonPathKey(path, k))...)
+			}
+		}
+		for _, k := range slices.Sorted(maps.Keys(a)) {
+			if _, found := e[k]; !found {
+				differences = append(differences, jsonPathKey(path, k)+": unexpected")
+			}
+		}
+		return differences
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			break
+		}
+		var differences []string
+		for i := range max(len(a), len(e)) {
+			switch {
+			case i >= len(a):
+				differences = append(differences, jsonPathIndex(path, i)+": missing")
+			case i >= len(e):
+				differences = append(differences, jsonPathIndex(path, i)+": unexpected")
+			default:
+				differences = append(differences, jsonDiff(a[i], e[i], jsonPathIndex(path, i))...)
+			}
+		}
+		return differences
+	}
+	if !deepequal.DeepEqual(actual, expected) {
+		return []string{fmt.Sprintf("%s: expected %s, got %s", path, jsonRaw(expected), jsonRaw(actual))}
+	}
+	return nil
+}
+
+// jsonDecode returns decoded arg (supported by JSONEqual) and true if it's a non-empty valid JSON.
+// Numbers are decoded as float64, but if useNumber is true then numbers
+// which can't be represented exactly by float64 are decoded as [json.Number].
+func jsonDecode(arg any, useNumber bool) (v any, ok bool) {
+	buf := jsonBytes(arg)
+	if len(buf) == 0 {
+		return nil, false
+	}
+	if !useNumber {
+		err := json.Unmarshal(buf, &v)
+		return v, err == nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if dec.Decode(&v) != nil || !errors.Is(dec.Decode(new(any)), io.EOF) {
+		return nil, false
+	}
+	return jsonNumbers(v), true
+}
+
+// jsonNumbers replaces [json.Number] in v with float64 when it's exact,
+// otherwise with a canonical [json.Number] (same for same values).
+func jsonNumbers(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k := range v {
+			v[k] = jsonNumbers(v[k])
+		}
+	case []any:
+		for i := range v {
+			v[i] = jsonNumbers(v[i])
+		}
+	case json.Number:
+		r, ok := new(big.Rat).SetString(string(v))
+		if !ok {
+			return v
+		}
+		if f, exact := r.Float64(); exact {
+			return f
+		}
+		if r.IsInt() {
+			return json.Number(r.Num().String())
+		}
+		return json.Number(r.FloatString(jsonFracDigits(r)))
+	}
+	return v
+}
+
+// jsonRat returns value of v if it's a decoded JSON number.
+func jsonRat(v any) (*big.Rat, bool) {
+	switch v := v.(type) {
+	case float64:
+		return new(big.Rat).SetFloat64(v), true
+	case json.Number:
+		return new(big.Rat).SetString(string(v))
+	}
+	return nil, false
+}
+
+// jsonFracDigits returns amount of digits after the decimal point
+// needed to represent r (which has a finite decimal representation) exactly.
+func jsonFracDigits(r *big.Rat) int {
+	x, ten := new(big.Rat).Set(r), big.NewRat(10, 1) //nolint:mnd // Decimal.
+	digits := 0
+	for ; !x.IsInt(); digits++ {
+		x.Mul(x, ten)
+	}
+	return digits
+}
+
+// jsonRaw returns v (decoded JSON) encoded back to JSON.
+func jsonRaw(v any) json.RawMessage {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return buf
+}
+
+func jsonKind(v any) string {
+	switch v.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	default:
+		return "number"
+	}
+}
+
+//nolint:gochecknoglobals // Const.
+var (
+	reJSONPathIdent = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	reJSONPathStep  = regexp.MustCompile(`^(?:\.([A-Za-z_][A-Za-z0-9_-]*)|\[(\d+)\]|\['((?:[^'\\]|\\.)*)'\]|\["((?:[^"\\]|\\.)*)"\])`)
+)
+
+// jsonPathKey returns JSONPath to the key in object at path.
+func jsonPathKey(path, key string) string {
+	if reJSONPathIdent.MatchString(key) {
+		return path + "." + key
+	}
+	return path + "[" + strconv.Quote(key) + "]"
+}
+
+// jsonPathIndex returns JSONPath to the element with index i in array at path.
+func jsonPathIndex(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+// parseJSONPath returns keys and indices (as strings) of JSON Pointer or JSONPath.
+func parseJSONPath(path string) []string {
+	keys := []string{}
+	switch {
+	case path == "":
+	case strings.HasPrefix(path, "/"):
+		for _, key := range strings.Split(path[1:], "/") {
+			keys = append(keys, strings.ReplaceAll(strings.ReplaceAll(key, "~1", "/"), "~0", "~"))
+		}
+	case strings.HasPrefix(path, "$"):
+		for rest := path[1:]; rest != ""; {
+			m := reJSONPathStep.FindStringSubmatch(rest)
+			if m == nil {
+				panic("unsupported JSONPath: " + path)
+			}
+			rest = rest[len(m[0]):]
+			switch {
+			case m[1] != "" || m[2] != "":
+				keys = append(keys, m[1]+m[2])
+			default:
+				keys = append(keys, unescapeJSONPath(m[3]+m[4]))
+			}
+		}
+	default:
+		panic("path is not a JSON Pointer or JSONPath: " + path)
+	}
+	return keys
+}
+
+func unescapeJSONPath(s string) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		buf.WriteByte(s[i])
+	}
+	return buf.String()
+}
+
+// jsonLookup returns value in doc at path defined by keys.
+// If there is no such value it returns a description why.
+func jsonLookup(doc any, keys []string) (v any, found bool, missing string) {
+	v = doc
+	for i, key := range keys {
+		switch cur := v.(type) {
+		case map[string]any:
+			v, found = cur[key]
+			if !found {
+				return nil, false, fmt.Sprintf("no key %q in object at %s", key, jsonPointer(keys[:i]))
+			}
+		case []any:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(cur) {
+				return nil, false, fmt.Sprintf("no index %s in array of %d elements at %s", key, len(cur), jsonPointer(keys[:i]))
+			}
+			v = cur[idx]
+		default:
+			return nil, false, fmt.Sprintf("no key %q in %s at %s", key, jsonKind(cur), jsonPointer(keys[:i]))
+		}
+	}
+	return v, true, ""
+}
+
+// jsonPointer returns JSON Pointer to the value at path defined by keys.
+func jsonPointer(keys []string) string {
+	if len(keys) == 0 {
+		return `"" (root)`
+	}
+	var buf strings.Builder
+	for _, key := range keys {
+		buf.WriteString("/" + strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1"))
+	}
+	return buf.String()
+}
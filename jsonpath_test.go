@@ -0,0 +1,102 @@
+package check_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+const jsonDoc = `{
+	"id": "x1",
+	"items": [
+		{"id": 1, "name": "pen", "tags": ["a", "b"]},
+		{"id": 2, "name": "cup", "tags": []}
+	],
+	"a/b": {"c~d": true},
+	"with space": null
+}`
+
+func TestJSONPath(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	raw := json.RawMessage(jsonDoc)
+	for _, doc := range []any{jsonDoc, []byte(jsonDoc), raw, &raw} {
+		t.JSONPath(doc, "$.id", "x1")
+		t.JSONPath(doc, "/id", "x1")
+		todo.JSONPath(doc, "$.id", "x2")
+	}
+
+	t.JSONPath(jsonDoc, "$.items[0].id", 1)
+	t.JSONPath(jsonDoc, "$.items[1].id", 2.0)
+	t.JSONPath(jsonDoc, "$['items'][0][\"name\"]", "pen")
+	t.JSONPath(jsonDoc, "/items/0/tags", []string{"a", "b"})
+	t.JSONPath(jsonDoc, "$.items[1].tags", []int{})
+	t.JSONPath(jsonDoc, "$.items[1]", map[string]any{"id": 2, "name": "cup", "tags": []any{}})
+	t.JSONPath(jsonDoc, "/a~1b/c~0d", true)
+	t.JSONPath(jsonDoc, `$["a/b"]['c~d']`, true)
+	t.JSONPath(jsonDoc, "$['with space']", nil)
+	t.JSONPath(`[1,2]`, "$[1]", 2)
+	t.JSONPath(`42`, "$", 42)
+	t.JSONPath(`42`, "", 42)
+	t.JSONPath(`{"":1}`, "/", 1)
+	todo.JSONPath(jsonDoc, "$.items[0].id", "1")
+	todo.JSONPath(jsonDoc, "$.items[0].tags", []string{"b", "a"})
+
+	// Missing values.
+	todo.JSONPath(jsonDoc, "$.missing", nil)
+	todo.JSONPath(jsonDoc, "$.items[2]", nil)
+	todo.JSONPath(jsonDoc, "/items/x", nil)
+	todo.JSONPath(jsonDoc, "$.id.x", nil)
+
+	// Invalid documents.
+	todo.JSONPath(`{`, "$", nil)
+	todo.JSONPath(``, "$", nil)
+	todo.JSONPath(nil, "$", nil)
+
+	t.PanicMatch(func() { t.JSONPath(jsonDoc, "items", 1) }, "not a JSON Pointer or JSONPath")
+	t.PanicMatch(func() { t.JSONPath(jsonDoc, "$.items[*]", 1) }, "unsupported JSONPath")
+	t.PanicMatch(func() { t.JSONPath(jsonDoc, "$..id", 1) }, "unsupported JSONPath")
+	t.PanicMatch(func() { t.JSONPath(jsonDoc, "$", func() {}) }, "expected can't be converted to JSON")
+	t.Panic(func() { t.JSONPath(42, "$", 42) })
+}
+
+func TestJSONSubset(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.JSONSubset(jsonDoc, jsonDoc)
+	t.JSONSubset(jsonDoc, `{}`)
+	t.JSONSubset(jsonDoc, `{"id":"x1"}`)
+	t.JSONSubset(jsonDoc, `{"items":[{"id":1},{"name":"cup"}]}`)
+	t.JSONSubset(jsonDoc, `{"a/b":{}}`)
+	t.JSONSubset(`[1,"a",null]`, `[1,"a",null]`)
+	todo.JSONSubset(jsonDoc, `{"id":"x2"}`)
+	todo.JSONSubset(jsonDoc, `{"missing":null}`)
+	todo.JSONSubset(jsonDoc, `{"items":[{"id":1}]}`)
+	todo.JSONSubset(jsonDoc, `{"items":[{"name":"cup"},{"id":1}]}`)
+	todo.JSONSubset(jsonDoc, `{"items":{}}`)
+	todo.JSONSubset(jsonDoc, `{"id":{}}`)
+	todo.JSONSubset(jsonDoc, `{"id":[]}`)
+	todo.JSONSubset(`{"a":1}`, `{"a":1,"b":2}`)
+	todo.JSONSubset(`1`, `1.5`)
+
+	t.JSONSubsetUnordered(jsonDoc, `{"items":[{"name":"cup"},{"id":1}]}`)
+	t.JSONSubsetUnordered(jsonDoc, `{"items":[{"name":"cup"}]}`)
+	t.JSONSubsetUnordered(`[1,2,3]`, `[3,1]`)
+	t.JSONSubsetUnordered(`[{"a":1,"b":1},{"a":1}]`, `[{"a":1},{"b":1}]`)
+	todo.JSONSubsetUnordered(`[1,2]`, `[1,1]`)
+	todo.JSONSubsetUnordered(`[{"a":1}]`, `[{"a":1},{"a":1}]`)
+	todo.JSONSubsetUnordered(jsonDoc, `{"items":[{"name":"box"}]}`)
+	todo.JSONSubset(`[3,1,2]`, `[1,2,3]`)
+
+	// Invalid documents.
+	todo.JSONSubset(`{`, `{}`)
+	todo.JSONSubset(`{}`, `{`)
+	todo.JSONSubset(nil, nil)
+	todo.JSONSubset(``, ``)
+	t.Panic(func() { t.JSONSubset(42, `{}`) })
+}
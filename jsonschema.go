@@ -0,0 +1,341 @@
+package check
+
+import (
+	"fmt"
+	"maps"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/powerman/check/internal/deepequal"
+)
+
+// JSONSchema checks that JSON document actual is valid according to
+// JSON Schema schema.
+//
+// Both actual and schema may have same types as in JSONEqual.
+//
+// It supports a practical subset of JSON Schema draft 2020-12:
+//   - boolean schemas: true and false
+//   - type (single type or array of types, incl. "integer")
+//   - enum, const
+//   - properties, required, additionalProperties
+//   - items, prefixItems, minItems, maxItems
+//   - minLength, maxLength, pattern (using [regexp] syntax)
+//   - minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf
+//   - allOf, anyOf, oneOf, not
+//   - $ref within the schema document ("#" or "#/json/pointer", e.g. "#/$defs/id")
+//
+// Other keywords are ignored.
+// Numbers are always decoded like with [TB.UseNumber], so limits and
+// multipleOf are checked exactly for decimal values (e.g. 0.3 is
+// a multiple of 0.1).
+// It panics if supported keyword has invalid value, $ref can't be resolved
+// or $ref refers to itself without moving to another JSON value (e.g. {"$ref":"#"}).
+//
+// In case any of actual or schema is nil or empty or is invalid JSON - check will fail.
+// On failure it shows JSON paths of all violations.
+func (t *checks) JSONSchema(actual, schema any, msg ...any) bool {
+	t.tb.Helper()
+	var violations []string
+	doc, okActual := jsonDecode(actual, true)
+	root, okSchema := jsonDecode(schema, true)
+	if okActual && okSchema {
+		violations = (&jsonSchema{root: root, refs: make(map[[2]string]bool)}).validate(root, doc, "$")
+	}
+	if okActual {
+		actual = jsonRaw(doc)
+	}
+	if okSchema {
+		schema = jsonRaw(root)
+	}
+	ok := okActual && okSchema && len(violations) == 0
+	return t.reportExplained(ok, msg,
+		callerFuncName(0),
+		[]string{nameActual, "Schema"},
+		[]any{actual, schema},
+		func() string {
+			if len(violations) == 0 {
+				return ""
+			}
+			return "Violations:\n  " + strings.Join(violations, "\n  ") + "\n"
+		})
+}
+
+// jsonSchema validates decoded JSON documents using decoded JSON Schema root.
+type jsonSchema struct {
+	root any
+	refs map[[2]string]bool // Pairs ($ref, path) being validated now.
+}
+
+// validate returns descriptions of all places where v at path is not valid according to schema.
+func (s *jsonSchema) validate(schema, v any, path string) []string {
+	switch schema := schema.(type) {
+	case bool:
+		if !schema {
+			return []string{path + ": not allowed by schema false"}
+		}
+		return nil
+	case map[string]any:
+		var violations []string
+		for _, keyword := range slices.Sorted(maps.Keys(schema)) {
+			violations = append(violations, s.validateKeyword(schema, keyword, v, path)...)
+		}
+		return violations
+	default:
+		panic(fmt.Sprintf("invalid JSON Schema: %s is not an object or boolean", jsonRaw(schema)))
+	}
+}
+
+//nolint:gocyclo,cyclop,funlen // Keywords are simple, keep them together.
+func (s *jsonSchema) validateKeyword(schema map[string]any, keyword string, v any, path string) []string {
+	value := schema[keyword]
+	violation := func(format string, args ...any) []string {
+		return []string{path + ": " + fmt.Sprintf(format, args...)}
+	}
+	switch keyword {
+	case "$ref":
+		ref := jsonSchemaString(keyword, value)
+		key := [2]string{ref, path}
+		if s.refs[key] {
+			panic("invalid JSON Schema: circular $ref: " + ref)
+		}
+		s.refs[key] = true
+		defer delete(s.refs, key)
+		return s.validate(s.resolve(ref), v, path)
+
+	case "type":
+		types := []any{value}
+		if list, ok := value.([]any); ok {
+			types = list
+		}
+		for _, typ := range types {
+			if jsonSchemaType(jsonSchemaString(keyword, typ), v) {
+				return nil
+			}
+		}
+		return violation("expected type %s, got %s", jsonRaw(value), jsonSchemaKind(v))
+	case "enum":
+		if !slices.ContainsFunc(jsonSchemaArray(keyword, value), func(e any) bool { return deepequal.DeepEqual(v, e) }) {
+			return violation("%s is not one of %s", jsonRaw(v), jsonRaw(value))
+		}
+	case "const":
+		if !deepequal.DeepEqual(v, value) {
+			return violation("expected %s, got %s", jsonRaw(value), jsonRaw(v))
+		}
+
+	case "properties":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		props := jsonSchemaObject(keyword, value)
+		var violations []string
+		for _, name := range slices.Sorted(maps.Keys(props)) {
+			if pv, found := obj[name]; found {
+				violations = append(violations, s.validate(props[name], pv, jsonPathKey(path, name))...)
+			}
+		}
+		return violations
+	case "required":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		var violations []string
+		for _, name := range jsonSchemaArray(keyword, value) {
+			name := jsonSchemaString(keyword, name)
+			if _, found := obj[name]; !found {
+				violations = append(violations, jsonPathKey(path, name)+": missing required property")
+			}
+		}
+		return violations
+	case "additionalProperties":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		props, _ := schema["properties"].(map[string]any)
+		var violations []string
+		for _, name := range slices.Sorted(maps.Keys(obj)) {
+			if _, found := props[name]; found {
+				continue
+			}
+			if value == false {
+				violations = append(violations, jsonPathKey(path, name)+": unexpected additional property")
+			} else {
+				violations = append(violations, s.validate(value, obj[name], jsonPathKey(path, name))...)
+			}
+		}
+		return violations
+
+	case "prefixItems":
+		arr, ok := v.([]any)
+		if !ok {
+			return nil
+		}
+		var violations []string
+		for i, item := range jsonSchemaArray(keyword, value) {
+			if i < len(arr) {
+				violations = append(violations, s.validate(item, arr[i], jsonPathIndex(path, i))...)
+			}
+		}
+		return violations
+	case "items":
+		arr, ok := v.([]any)
+		if !ok {
+			return nil
+		}
+		prefix, _ := schema["prefixItems"].([]any)
+		var violations []string
+		for i := len(prefix); i < len(arr); i++ {
+			violations = append(violations, s.validate(value, arr[i], jsonPathIndex(path, i))...)
+		}
+		return violations
+	case "minItems", "maxItems":
+		if arr, ok := v.([]any); ok && !jsonSchemaLimit(keyword, value, len(arr)) {
+			return violation("%d items violates %s %s", len(arr), keyword, jsonRaw(value))
+		}
+
+	case "minLength", "maxLength":
+		if str, ok := v.(string); ok && !jsonSchemaLimit(keyword, value, utf8.RuneCountInString(str)) {
+			return violation("length %d violates %s %s", utf8.RuneCountInString(str), keyword, jsonRaw(value))
+		}
+	case "pattern":
+		re := regexp.MustCompile(jsonSchemaString(keyword, value))
+		if str, ok := v.(string); ok && !re.MatchString(str) {
+			return violation("%s doesn't match pattern %s", jsonRaw(v), jsonRaw(value))
+		}
+
+	case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf":
+		limit, ok := jsonRat(value)
+		if !ok {
+			panic(fmt.Sprintf("invalid JSON Schema: %s is not a number", keyword))
+		}
+		if keyword == "multipleOf" && limit.Sign() <= 0 {
+			panic("invalid JSON Schema: multipleOf is not a positive number")
+		}
+		n, ok := jsonRat(v)
+		if !ok {
+			return nil
+		}
+		var valid bool
+		switch keyword {
+		case "minimum":
+			valid = n.Cmp(limit) >= 0
+		case "maximum":
+			valid = n.Cmp(limit) <= 0
+		case "exclusiveMinimum":
+			valid = n.Cmp(limit) > 0
+		case "exclusiveMaximum":
+			valid = n.Cmp(limit) < 0
+		case "multipleOf":
+			valid = new(big.Rat).Quo(n, limit).IsInt()
+		}
+		if !valid {
+			return violation("%s violates %s %s", jsonRaw(v), keyword, jsonRaw(value))
+		}
+
+	case "allOf":
+		var violations []string
+		for _, sub := range jsonSchemaArray(keyword, value) {
+			violations = append(violations, s.validate(sub, v, path)...)
+		}
+		return violations
+	case "anyOf":
+		if s.matches(keyword, value, v, path) == 0 {
+			return violation("doesn't match any schema in anyOf")
+		}
+	case "oneOf":
+		if n := s.matches(keyword, value, v, path); n != 1 {
+			return violation("matches %d schemas in oneOf instead of 1", n)
+		}
+	case "not":
+		if len(s.validate(value, v, path)) == 0 {
+			return violation("must not match schema in not")
+		}
+	}
+	return nil
+}
+
+// matches returns amount of schemas in subschemas (value of keyword) which are valid for v at path.
+func (s *jsonSchema) matches(keyword string, subschemas, v any, path string) int {
+	n := 0
+	for _, sub := range jsonSchemaArray(keyword, subschemas) {
+		if len(s.validate(sub, v, path)) == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// resolve returns subschema referenced by ref within root schema.
+func (s *jsonSchema) resolve(ref string) any {
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok || (pointer != "" && !strings.HasPrefix(pointer, "/")) {
+		panic("unsupported JSON Schema $ref: " + ref)
+	}
+	v, found, _ := jsonLookup(s.root, parseJSONPath(pointer))
+	if !found {
+		panic("unresolvable JSON Schema $ref: " + ref)
+	}
+	return v
+}
+
+func jsonSchemaType(typ string, v any) bool {
+	switch typ {
+	case "integer":
+		n, ok := jsonRat(v)
+		return ok && n.IsInt()
+	case "object", "array", "string", "boolean", "null", "number":
+		return jsonKind(v) == typ
+	default:
+		panic("invalid JSON Schema: unknown type " + typ)
+	}
+}
+
+// jsonSchemaKind returns JSON Schema type of v.
+func jsonSchemaKind(v any) string {
+	if jsonSchemaType("integer", v) {
+		return "integer"
+	}
+	return jsonKind(v)
+}
+
+// jsonSchemaLimit returns true if n is within limit (value of minX/maxX keyword).
+func jsonSchemaLimit(keyword string, limit any, n int) bool {
+	l, ok := jsonRat(limit)
+	if !ok || !l.IsInt() || l.Sign() < 0 {
+		panic(fmt.Sprintf("invalid JSON Schema: %s is not a non-negative integer", keyword))
+	}
+	if strings.HasPrefix(keyword, "min") {
+		return big.NewRat(int64(n), 1).Cmp(l) >= 0
+	}
+	return big.NewRat(int64(n), 1).Cmp(l) <= 0
+}
+
+func jsonSchemaString(keyword string, v any) string {
+	s, ok := v.(string)
+	if !ok {
+		panic(fmt.Sprintf("invalid JSON Schema: %s is not a string", keyword))
+	}
+	return s
+}
+
+func jsonSchemaArray(keyword string, v any) []any {
+	arr, ok := v.([]any)
+	if !ok {
+		panic(fmt.Sprintf("invalid JSON Schema: %s is not an array", keyword))
+	}
+	return arr
+}
+
+func jsonSchemaObject(keyword string, v any) map[string]any {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("invalid JSON Schema: %s is not an object", keyword))
+	}
+	return obj
+}
@@ -0,0 +1,127 @@
+package check_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+const jsonSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type": "object",
+	"required": ["id", "items"],
+	"properties": {
+		"id": {"type": "string", "pattern": "^x[0-9]+$", "minLength": 2, "maxLength": 5},
+		"items": {"type": "array", "minItems": 1, "maxItems": 3, "items": {"$ref": "#/$defs/item"}},
+		"kind": {"enum": ["a", "b"]},
+		"version": {"const": 1}
+	},
+	"additionalProperties": false,
+	"$defs": {
+		"item": {
+			"type": "object",
+			"required": ["id"],
+			"properties": {
+				"id": {"type": "integer", "minimum": 1, "exclusiveMaximum": 100},
+				"price": {"type": ["number", "null"], "exclusiveMinimum": 0, "maximum": 10, "multipleOf": 0.5}
+			}
+		}
+	}
+}`
+
+func TestJSONSchema(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	valid := `{"id":"x1","items":[{"id":1},{"id":99,"price":9.5},{"id":2,"price":null}],"kind":"b","version":1.0}`
+	raw := json.RawMessage(valid)
+	for _, doc := range []any{valid, []byte(valid), raw, &raw} {
+		t.JSONSchema(doc, jsonSchema)
+		t.JSONSchema(doc, []byte(jsonSchema))
+		t.JSONSchema(doc, json.RawMessage(jsonSchema))
+		todo.JSONSchema(doc, `false`)
+	}
+
+	for _, doc := range []string{
+		`[]`,
+		`{"items":[{"id":1}]}`,
+		`{"id":"y1","items":[{"id":1}]}`,
+		`{"id":"x","items":[{"id":1}]}`,
+		`{"id":"x12345","items":[{"id":1}]}`,
+		`{"id":1,"items":[{"id":1}]}`,
+		`{"id":"x1","items":[]}`,
+		`{"id":"x1","items":[{"id":1},{"id":1},{"id":1},{"id":1}]}`,
+		`{"id":"x1","items":[{}]}`,
+		`{"id":"x1","items":[{"id":0}]}`,
+		`{"id":"x1","items":[{"id":100}]}`,
+		`{"id":"x1","items":[{"id":1.5}]}`,
+		`{"id":"x1","items":[{"id":1,"price":0}]}`,
+		`{"id":"x1","items":[{"id":1,"price":10.5}]}`,
+		`{"id":"x1","items":[{"id":1,"price":0.3}]}`,
+		`{"id":"x1","items":[{"id":1,"price":"1"}]}`,
+		`{"id":"x1","items":[{"id":1}],"kind":"c"}`,
+		`{"id":"x1","items":[{"id":1}],"version":2}`,
+		`{"id":"x1","items":[{"id":1}],"extra":true}`,
+	} {
+		todo.JSONSchema(doc, jsonSchema, doc)
+	}
+
+	t.JSONSchema(`"x"`, `true`)
+	t.JSONSchema(`"x"`, `{}`)
+	t.JSONSchema(`[1,"a",true,false]`, `{"prefixItems":[{"type":"integer"},{"type":"string"}],"items":{"type":"boolean"}}`)
+	todo.JSONSchema(`[1,"a",2]`, `{"prefixItems":[{"type":"integer"},{"type":"string"}],"items":{"type":"boolean"}}`)
+	t.JSONSchema(`[1]`, `{"prefixItems":[{"type":"integer"},{"type":"string"}]}`)
+	t.JSONSchema(`{"a":1,"b":2}`, `{"properties":{"a":{}},"additionalProperties":{"type":"integer"}}`)
+	todo.JSONSchema(`{"a":1,"b":"2"}`, `{"properties":{"a":{}},"additionalProperties":{"type":"integer"}}`)
+	t.JSONSchema(`"ab"`, `{"allOf":[{"type":"string"},{"minLength":2}]}`)
+	todo.JSONSchema(`"a"`, `{"allOf":[{"type":"string"},{"minLength":2}]}`)
+	t.JSONSchema(`1`, `{"anyOf":[{"type":"string"},{"type":"integer"}]}`)
+	todo.JSONSchema(`null`, `{"anyOf":[{"type":"string"},{"type":"integer"}]}`)
+	t.JSONSchema(`1`, `{"oneOf":[{"type":"number"},{"type":"string"}]}`)
+	todo.JSONSchema(`1`, `{"oneOf":[{"type":"number"},{"type":"integer"}]}`)
+	todo.JSONSchema(`null`, `{"oneOf":[{"type":"number"},{"type":"integer"}]}`)
+	t.JSONSchema(`1`, `{"not":{"type":"string"}}`)
+	todo.JSONSchema(`"1"`, `{"not":{"type":"string"}}`)
+	t.JSONSchema(`"日本"`, `{"maxLength":2}`)
+	t.JSONSchema(`[[[]]]`, `{"items":{"$ref":"#"},"maxItems":1}`)
+	todo.JSONSchema(`[[[1]]]`, `{"items":{"$ref":"#"},"maxItems":1,"type":"array"}`)
+	todo.JSONSchema(`12345678901234567891`, `{"const":12345678901234567890}`)
+	t.JSONSchema(`0.3`, `{"multipleOf":0.1}`)
+	t.JSONSchema(`19.99`, `{"multipleOf":0.01}`)
+	todo.JSONSchema(`19.999`, `{"multipleOf":0.01}`)
+	t.JSONSchema(`0.3`, `{"minimum":0.3,"maximum":0.3}`)
+	todo.JSONSchema(`0.30000000000000004`, `{"maximum":0.3}`)
+	t.JSONSchema(`{"next":{"next":null}}`,
+		`{"anyOf":[{"type":"null"},{"type":"object","properties":{"next":{"$ref":"#"}}}]}`)
+	todo.UseNumber().JSONSchema(`12345678901234567891`, `{"const":12345678901234567890}`)
+	t.UseNumber().JSONSchema(`12345678901234567890`, `{"type":"integer","multipleOf":3}`)
+	todo.UseNumber().JSONSchema(`12345678901234567891`, `{"type":"integer","multipleOf":3}`)
+
+	// Invalid documents.
+	todo.JSONSchema(`{`, `{}`)
+	todo.JSONSchema(`{}`, `{`)
+	todo.JSONSchema(nil, `{}`)
+	todo.JSONSchema(`{}`, nil)
+
+	// Invalid schemas.
+	t.PanicMatch(func() { t.JSONSchema(`1`, `1`) }, "not an object or boolean")
+	t.PanicMatch(func() { t.JSONSchema(`1`, `{"type":1}`) }, "type is not a string")
+	t.PanicMatch(func() { t.JSONSchema(`1`, `{"type":"int"}`) }, "unknown type int")
+	t.PanicMatch(func() { t.JSONSchema(`1`, `{"enum":1}`) }, "enum is not an array")
+	t.PanicMatch(func() { t.JSONSchema(`{}`, `{"properties":[]}`) }, "properties is not an object")
+	t.PanicMatch(func() { t.JSONSchema(`1`, `{"minimum":"1"}`) }, "minimum is not a number")
+	t.PanicMatch(func() { t.JSONSchema(`1`, `{"multipleOf":0}`) }, "multipleOf is not a positive number")
+	t.PanicMatch(func() { t.JSONSchema(`"a"`, `{"multipleOf":-2}`) }, "multipleOf is not a positive number")
+	t.PanicMatch(func() { t.JSONSchema(`1`, `{"$ref":"#"}`) }, "circular [$]ref: #")
+	t.PanicMatch(func() {
+		t.JSONSchema(`1`, `{"$defs":{"a":{"$ref":"#/$defs/b"},"b":{"anyOf":[{"$ref":"#/$defs/a"}]}},"$ref":"#/$defs/a"}`)
+	},
+		"circular [$]ref")
+	t.PanicMatch(func() { t.JSONSchema(`[]`, `{"minItems":-1}`) }, "minItems is not a non-negative integer")
+	t.PanicMatch(func() { t.JSONSchema(`"a"`, `{"pattern":"("}`) }, "regexp")
+	t.PanicMatch(func() { t.JSONSchema(`1`, `{"$ref":"#/$defs/x"}`) }, "unresolvable JSON Schema [$]ref")
+	t.PanicMatch(func() { t.JSONSchema(`1`, `{"$ref":"other.json"}`) }, "unsupported JSON Schema [$]ref")
+	t.Panic(func() { t.JSONSchema(1, `{}`) })
+}
@@ -0,0 +1,265 @@
+package check
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	leakGracePeriod  = time.Second
+	leakPollInterval = 10 * time.Millisecond
+)
+
+// leakIgnoreFuncs contains prefixes of functions which are ignored
+// when found at the top of goroutine's stack
+// or in goroutine's "created by" line:
+// such goroutines belong to runtime and testing packages, not to the code under test.
+//
+//nolint:gochecknoglobals // Const.
+var leakIgnoreFuncs = []string{
+	"runtime.",
+	"testing.",
+	"os/signal.",
+}
+
+// NoLeaks checks that there are no resources leaked by the test.
+//
+// It takes a snapshot of current state right now and registers a Cleanup
+// which takes another snapshot at the end of the test and compares them.
+// Leaked resources are:
+//   - goroutines (except ones started by runtime or testing packages)
+//   - open file descriptors (Linux only: uses /proc/self/fd)
+//   - files and directories in [os.TempDir] (except directories
+//     created by [testing.TB.TempDir] of this test and its subtests)
+//
+// Goroutines and files may need some time to finish and be closed after the test,
+// so check waits up to 1 second (or [TB.Timeout], if set) for them to disappear.
+//
+// Call NoLeaks before [testing.TB.TempDir] or other Cleanup-registered resources:
+// cleanups run in last added, first called order,
+// so this way they'll be released before NoLeaks checks for leaks.
+//
+// Resources used by other tests running at the same time will be reported as leaked,
+// so don't use it in parallel tests.
+//
+// Result of the check is reported at the end of the test, so it always
+// returns true (like any other checker it returns bool for consistency).
+func (t *checks) NoLeaks(msg ...any) bool {
+	t.tb.Helper()
+	before := newLeakSnapshot(t.tb.Name())
+	t.tb.Cleanup(func() {
+		t.tb.Helper()
+		t.checkLeaks(before, msg)
+	})
+	return true
+}
+
+func (c *checks) checkLeaks(before leakSnapshot, msg []any) {
+	c.tb.Helper()
+
+	timeout := leakGracePeriod
+	if c.timeout > 0 {
+		timeout = c.timeout
+	}
+	deadline := time.Now().Add(timeout)
+	leaked := newLeakSnapshot(c.tb.Name()).leakedSince(before)
+	for !leaked.empty() && time.Now().Before(deadline) {
+		time.Sleep(leakPollInterval)
+		leaked = newLeakSnapshot(c.tb.Name()).leakedSince(before)
+	}
+
+	name, args := []string{}, []any{}
+	if len(leaked.goroutines) > 0 {
+		name = append(name, "Stacks")
+		args = append(args, note(strings.Join(sortedValues(leaked.goroutines), "\n\n")))
+	}
+	if len(leaked.fds) > 0 {
+		lines := make([]string, 0, len(leaked.fds))
+		for fd, target := range leaked.fds {
+			lines = append(lines, fd+" -> "+target)
+		}
+		slices.Sort(lines)
+		name = append(name, "FDs")
+		args = append(args, note(strings.Join(lines, "\n")))
+	}
+	if len(leaked.tempFiles) > 0 {
+		name = append(name, "Files")
+		args = append(args, note(strings.Join(sortedValues(leaked.tempFiles), "\n")))
+	}
+	c.report(leaked.empty(), msg,
+		"NoLeaks",
+		name,
+		args)
+}
+
+// leakSnapshot contains state of resources which may leak.
+// Keys are goroutine IDs, file descriptor numbers and temp file names,
+// values are goroutine stacks, file descriptor targets and temp file paths.
+type leakSnapshot struct {
+	goroutines map[string]string
+	fds        map[string]string
+	tempFiles  map[string]string
+}
+
+// newLeakSnapshot returns current state of resources used by test with given name.
+func newLeakSnapshot(testName string) leakSnapshot {
+	return leakSnapshot{
+		goroutines: goroutineStacks(),
+		fds:        openFDs(),
+		tempFiles:  tempFiles(testName),
+	}
+}
+
+// leakedSince returns resources from s which are absent in before.
+func (s leakSnapshot) leakedSince(before leakSnapshot) leakSnapshot {
+	return leakSnapshot{
+		goroutines: newKeys(s.goroutines, before.goroutines),
+		fds:        changedKeys(s.fds, before.fds),
+		tempFiles:  newKeys(s.tempFiles, before.tempFiles),
+	}
+}
+
+func (s leakSnapshot) empty() bool {
+	return len(s.goroutines) == 0 && len(s.fds) == 0 && len(s.tempFiles) == 0
+}
+
+func newKeys(now, before map[string]string) map[string]string {
+	leaked := make(map[string]string)
+	for k, v := range now {
+		if _, ok := before[k]; !ok {
+			leaked[k] = v
+		}
+	}
+	return leaked
+}
+
+func changedKeys(now, before map[string]string) map[string]string {
+	leaked := make(map[string]string)
+	for k, v := range now {
+		if prev, ok := before[k]; !ok || prev != v {
+			leaked[k] = v
+		}
+	}
+	return leaked
+}
+
+func sortedValues(m map[string]string) []string {
+	values := make([]string, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	slices.Sort(values)
+	return values
+}
+
+// goroutineStacks returns stacks of all goroutines except current one
+// and ones ignored by leakIgnoreFuncs.
+func goroutineStacks() map[string]string {
+	buf := make([]byte, 64<<10) //nolint:mnd // Initial buffer size.
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf)) //nolint:mnd // Grow buffer.
+	}
+
+	stacks := make(map[string]string)
+	for i, stack := range bytes.Split(buf, []byte("\n\n")) {
+		if i == 0 { // Current goroutine.
+			continue
+		}
+		header, frames, _ := strings.Cut(string(stack), "\n")
+		id, ok := strings.CutPrefix(header, "goroutine ")
+		if !ok {
+			continue
+		}
+		id, _, _ = strings.Cut(id, " ")
+		if !isIgnoredStack(frames) {
+			stacks[id] = strings.TrimSpace(string(stack))
+		}
+	}
+	return stacks
+}
+
+func isIgnoredStack(frames string) bool {
+	top, _, _ := strings.Cut(frames, "\n")
+	var createdBy string
+	if i := strings.LastIndex(frames, "created by "); i != -1 {
+		createdBy = frames[i+len("created by "):]
+	}
+	for _, prefix := range leakIgnoreFuncs {
+		if strings.HasPrefix(top, prefix) || strings.HasPrefix(createdBy, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// openFDs returns targets of open file descriptors.
+// It returns nothing on OS without /proc/self/fd.
+func openFDs() map[string]string {
+	const dir = "/proc/self/fd"
+	fds := make(map[string]string)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fds
+	}
+	// ReadDir's own (already closed) descriptor points to the dir it reads.
+	self, _ := filepath.EvalSymlinks(dir)
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		target, err := os.Readlink(filepath.Join(dir, entry.Name()))
+		if err != nil || target == self {
+			continue
+		}
+		fds[entry.Name()] = target
+	}
+	return fds
+}
+
+// tempFiles returns paths of files and directories in [os.TempDir]
+// except directories created by [testing.TB.TempDir] of test with given name
+// (and its subtests): they're removed by Cleanup which may run after NoLeaks.
+func tempFiles(testName string) map[string]string {
+	files := make(map[string]string)
+	entries, err := os.ReadDir(os.TempDir())
+	if err != nil {
+		return files
+	}
+	prefix := testTempDirPrefix(testName)
+	for _, entry := range entries {
+		if prefix != "" && entry.IsDir() && strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		path := filepath.Join(os.TempDir(), entry.Name())
+		if entry.IsDir() {
+			path += string(filepath.Separator)
+		}
+		files[entry.Name()] = path
+	}
+	return files
+}
+
+// testTempDirPrefix returns prefix of names of directories created by
+// [testing.TB.TempDir] of test with given name (same as used by testing).
+func testTempDirPrefix(testName string) string {
+	const maxLen = 64
+	const allowed = "!#$%&()+,-.=@^_{}~ "
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) || strings.ContainsRune(allowed, r) {
+			return r
+		}
+		return -1
+	}, testName[:min(len(testName), maxLen)])
+}
@@ -0,0 +1,123 @@
+package check_test
+
+import (
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/powerman/check"
+)
+
+// Leak tests are deliberately not parallel: NoLeaks would report
+// goroutines and temp files of other tests running at the same time.
+
+// leakTB is a fakeTB which calls Cleanup functions on runCleanups
+// (instead of at the end of a real test) to examine NoLeaks report.
+type leakTB struct {
+	*fakeTB
+
+	cleanups []func()
+}
+
+func (l *leakTB) Cleanup(f func()) { l.cleanups = append(l.cleanups, f) }
+
+func (l *leakTB) runCleanups() {
+	for i := len(l.cleanups) - 1; i >= 0; i-- {
+		l.cleanups[i]()
+	}
+	l.cleanups = nil
+}
+
+//nolint:paralleltest // NoLeaks can't be used in parallel tests.
+func TestNoLeaks(tt *testing.T) {
+	t := check.T(tt)
+	t.True(t.NoLeaks())
+
+	done := make(chan struct{})
+	go func() { <-done }()
+	close(done)
+
+	f, err := os.CreateTemp("", "check-test-")
+	t.Must(t.Nil(err))
+	t.Nil(f.Close())
+	t.Nil(os.Remove(f.Name()))
+
+	_ = t.TempDir()
+}
+
+//nolint:paralleltest // NoLeaks can't be used in parallel tests.
+func TestNoLeaksGoroutine(tt *testing.T) {
+	t := check.T(tt)
+	fake := &leakTB{fakeTB: &fakeTB{name: "fakeNoLeaksGoroutine"}}
+	check.New(fake).Timeout(10 * time.Millisecond).NoLeaks()
+	done := make(chan struct{})
+	defer close(done)
+	go leakedGoroutine(done)
+	fake.runCleanups()
+
+	t.Equal(fake.errorfCalls, 1)
+	t.Must(t.Len(fake.msgs, 1))
+	t.Contains(fake.msgs[0], "Checker:  NoLeaks\n")
+	t.Match(fake.msgs[0], `(?s)Stacks: +goroutine \d+ .*check_test\.leakedGoroutine\(`)
+	t.Match(fake.msgs[0], `created by \S+/check_test\.TestNoLeaksGoroutine `)
+	t.NotContains(fake.msgs[0], "FDs:")
+	t.NotContains(fake.msgs[0], "Files:")
+}
+
+func leakedGoroutine(done <-chan struct{}) { <-done }
+
+//nolint:paralleltest // NoLeaks can't be used in parallel tests.
+func TestNoLeaksFD(tt *testing.T) {
+	t := check.T(tt)
+	if runtime.GOOS != "linux" {
+		t.Skip("open file descriptors are checked only on Linux")
+	}
+	fake := &leakTB{fakeTB: &fakeTB{name: "fakeNoLeaksFD"}}
+	check.New(fake).Timeout(10 * time.Millisecond).NoLeaks()
+	f, err := os.Open("leak_test.go")
+	t.Must(t.Nil(err))
+	defer func() { t.Nil(f.Close()) }()
+	fake.runCleanups()
+
+	t.Equal(fake.errorfCalls, 1)
+	t.Must(t.Len(fake.msgs, 1))
+	t.Contains(fake.msgs[0], "Checker:  NoLeaks\n")
+	t.Match(fake.msgs[0], `FDs: +\d+ -> /.*/leak_test\.go\n`)
+	t.NotContains(fake.msgs[0], "Stacks:")
+}
+
+//nolint:paralleltest // NoLeaks can't be used in parallel tests.
+func TestNoLeaksTempFile(tt *testing.T) {
+	t := check.T(tt)
+	fake := &leakTB{fakeTB: &fakeTB{name: "fakeNoLeaksTempFile"}}
+	check.New(fake).Timeout(10 * time.Millisecond).NoLeaks()
+	f, err := os.CreateTemp("", "check-test-")
+	t.Must(t.Nil(err))
+	t.Nil(f.Close())
+	defer func() { t.Nil(os.Remove(f.Name())) }()
+	fake.runCleanups()
+
+	t.Equal(fake.errorfCalls, 1)
+	t.Must(t.Len(fake.msgs, 1))
+	t.Contains(fake.msgs[0], "Checker:  NoLeaks\n")
+	t.Contains(fake.msgs[0], "Files:    "+f.Name()+"\n")
+	t.NotContains(fake.msgs[0], "Stacks:")
+	t.NotContains(fake.msgs[0], "FDs:")
+}
+
+// Directories created by TempDir are removed by Cleanup which may run
+// after NoLeaks check, so they're not reported.
+//
+//nolint:paralleltest // NoLeaks can't be used in parallel tests.
+func TestNoLeaksTempDir(tt *testing.T) {
+	t := check.T(tt)
+	fake := &leakTB{fakeTB: &fakeTB{name: "fakeNoLeaksTempDir/sub:1"}}
+	check.New(fake).Timeout(10 * time.Millisecond).NoLeaks()
+	dir, err := os.MkdirTemp("", "fakeNoLeaksTempDirsub1") // Like TempDir does.
+	t.Must(t.Nil(err))
+	defer func() { t.Nil(os.Remove(dir)) }()
+	fake.runCleanups()
+
+	t.Zero(fake.errorfCalls)
+}
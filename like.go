@@ -0,0 +1,182 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/powerman/check/internal/deepequal"
+)
+
+// Like checks that actual is like expected: only values which are
+// non-zero in expected (see [TB.Zero]) are compared, everything else is ignored.
+//
+// Comparison is recursive:
+//   - struct: zero fields of expected are ignored, other fields are compared like Like
+//   - map: every key of expected must exist in actual (which may have extra keys),
+//     values are compared like Like
+//   - slice or array: lengths must be equal, elements are compared like Like
+//     (so zero elements of expected are ignored too)
+//   - pointer and interface: values they point to are compared like Like
+//   - values of other types and types with Equal method (like [time.Time])
+//     are compared like DeepEqual
+//
+// Expected may contain [Matcher] like in DeepEqual.
+//
+// On failure it shows (and diff) only compared values of actual
+// (ignored ones are replaced by zero values), and paths of all mismatches.
+func (t *checks) Like(actual, expected any, msg ...any) bool {
+	t.tb.Helper()
+	mismatches := like(reflect.ValueOf(actual), reflect.ValueOf(expected), "")
+	if len(mismatches) != 0 {
+		actual = valueOf(likeProject(reflect.ValueOf(actual), reflect.ValueOf(expected)))
+	}
+	return t.report2Explained(actual, expected, msg, len(mismatches) == 0,
+		explainMismatches(mismatches))
+}
+
+// NotLike checks !Like(actual, expected).
+func (t *checks) NotLike(actual, expected any, msg ...any) bool {
+	t.tb.Helper()
+	return t.report1(actual, msg,
+		len(like(reflect.ValueOf(actual), reflect.ValueOf(expected), "")) != 0)
+}
+
+// like returns descriptions of all mismatches between actual and non-zero parts of expected.
+func like(actual, expected reflect.Value, path string) []string { //nolint:gocyclo,cyclop // By design.
+	if !expected.IsValid() || isZero(valueOf(expected)) {
+		return nil
+	}
+	if expected.Kind() == reflect.Interface {
+		expected = expected.Elem()
+		if actual.IsValid() && actual.Kind() == reflect.Interface {
+			actual = actual.Elem()
+		}
+	}
+	mismatch := func(format string, args ...any) []string {
+		if path == "" {
+			path = "(root)"
+		}
+		return []string{path + ": " + fmt.Sprintf(format, args...)}
+	}
+
+	if isLikeLeaf(expected) {
+		return matchValue(actual, expected, path)
+	}
+	if !actual.IsValid() || actual.Type() != expected.Type() {
+		return mismatch("expected %s, got %s", expected.Type(), typeName(actual))
+	}
+
+	var mismatches []string
+	switch expected.Kind() { //nolint:exhaustive // Other kinds are leaves.
+	case reflect.Pointer:
+		if actual.IsNil() {
+			return mismatch("expected non-nil %s, got nil", expected.Type())
+		}
+		return like(actual.Elem(), expected.Elem(), path)
+	case reflect.Struct:
+		for i := range expected.NumField() {
+			mismatches = append(mismatches,
+				like(actual.Field(i), expected.Field(i), path+"."+expected.Type().Field(i).Name)...)
+		}
+	case reflect.Slice, reflect.Array:
+		if actual.Len() != expected.Len() {
+			return mismatch("expected %d elements, got %d", expected.Len(), actual.Len())
+		}
+		for i := range expected.Len() {
+			mismatches = append(mismatches, like(actual.Index(i), expected.Index(i), fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case reflect.Map:
+		for _, k := range sortedMapKeys(expected) {
+			keyPath := fmt.Sprintf("%s[%s]", path, matcherArg(valueOf(k)))
+			if a := actual.MapIndex(k); !a.IsValid() {
+				mismatches = append(mismatches, keyPath+": missing")
+			} else {
+				mismatches = append(mismatches, like(a, expected.MapIndex(k), keyPath)...)
+			}
+		}
+	}
+	return mismatches
+}
+
+// isLikeLeaf returns true if v must be compared as a whole by Like.
+func isLikeLeaf(v reflect.Value) bool {
+	if _, ok := v.Type().MethodByName("Equal"); ok || v.Type().Implements(typMatcher) {
+		return true
+	}
+	switch v.Kind() { //nolint:exhaustive // Other kinds are leaves.
+	case reflect.Pointer, reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return false
+	}
+	return true
+}
+
+// likeProject returns copy of actual with only values compared by Like(actual, expected).
+// If actual isn't like expected by type it returns actual as is.
+func likeProject(actual, expected reflect.Value) reflect.Value {
+	if !expected.IsValid() || !actual.IsValid() {
+		return actual
+	}
+	if expected.Kind() == reflect.Interface && actual.Kind() == reflect.Interface && !expected.IsNil() && !actual.IsNil() {
+		projected := likeProject(actual.Elem(), expected.Elem())
+		v := reflect.New(actual.Type()).Elem()
+		v.Set(deepequal.Settable(projected))
+		return v
+	}
+	if actual.Type() != expected.Type() || isLikeLeaf(expected) {
+		return actual
+	}
+
+	switch expected.Kind() { //nolint:exhaustive // Other kinds are leaves.
+	case reflect.Pointer:
+		if actual.IsNil() || expected.IsNil() {
+			return actual
+		}
+		v := reflect.New(actual.Type().Elem())
+		v.Elem().Set(deepequal.Settable(likeProject(actual.Elem(), expected.Elem())))
+		return v
+	case reflect.Struct:
+		v := reflect.New(actual.Type()).Elem()
+		for i := range expected.NumField() {
+			if !isZero(valueOf(expected.Field(i))) {
+				deepequal.Settable(v.Field(i)).Set(deepequal.Settable(likeProject(actual.Field(i), expected.Field(i))))
+			}
+		}
+		return v
+	case reflect.Slice, reflect.Array:
+		if actual.Len() != expected.Len() || (actual.Kind() == reflect.Slice && actual.IsNil()) {
+			return actual
+		}
+		v := reflect.New(actual.Type()).Elem()
+		if actual.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(actual.Type(), actual.Len(), actual.Len()))
+		}
+		for i := range expected.Len() {
+			if !isZero(valueOf(expected.Index(i))) {
+				deepequal.Settable(v.Index(i)).Set(deepequal.Settable(likeProject(actual.Index(i), expected.Index(i))))
+			}
+		}
+		return v
+	case reflect.Map:
+		if actual.IsNil() {
+			return actual
+		}
+		v := reflect.MakeMap(actual.Type())
+		for _, k := range expected.MapKeys() {
+			if a := actual.MapIndex(k); a.IsValid() {
+				v.SetMapIndex(deepequal.Settable(k), deepequal.Settable(likeProject(a, expected.MapIndex(k))))
+			}
+		}
+		return v
+	}
+	return actual
+}
+
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return strings.Compare(fmt.Sprintf("%#v", valueOf(a)), fmt.Sprintf("%#v", valueOf(b)))
+	})
+	return keys
+}
@@ -0,0 +1,75 @@
+package check_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/powerman/check"
+)
+
+type likeAddr struct {
+	City string
+	Zip  string
+}
+
+type likeUser struct {
+	ID      int
+	Name    string
+	Email   string
+	Addr    *likeAddr
+	Tags    []string
+	Attrs   map[string]any
+	Created time.Time
+	extra   any
+}
+
+func TestLike(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	now := time.Now()
+	actual := likeUser{
+		ID:      42,
+		Name:    "Alice",
+		Email:   "alice@example.com",
+		Addr:    &likeAddr{City: "Paris", Zip: "75001"},
+		Tags:    []string{"a", "b"},
+		Attrs:   map[string]any{"age": 30, "role": "admin"},
+		Created: now,
+		extra:   "x",
+	}
+
+	t.Like(actual, likeUser{})
+	t.Like(actual, actual)
+	t.Like(&actual, &actual)
+	t.Like(actual, likeUser{Name: "Alice"})
+	t.Like(actual, likeUser{Name: "Alice", Addr: &likeAddr{City: "Paris"}})
+	t.Like(actual, likeUser{Tags: []string{"", "b"}})
+	t.Like(actual, likeUser{Attrs: map[string]any{"role": "admin"}})
+	t.Like(actual, likeUser{Attrs: map[string]any{"age": check.Gt(18)}})
+	t.Like(actual, likeUser{Created: now.UTC()})
+	t.Like(actual, likeUser{ID: 42, extra: "x"})
+	t.Like(actual.Tags, []string{"a", ""})
+	t.Like(42, 42)
+	t.Like(42, 0)
+	t.Like(nil, nil)
+	t.Like([]any{1, likeAddr{City: "Paris", Zip: "1"}}, []any{nil, likeAddr{City: "Paris"}})
+
+	todo.Like(actual, likeUser{Name: "Bob"})
+	todo.Like(actual, likeUser{Addr: &likeAddr{Zip: "1"}})
+	todo.Like(likeUser{}, likeUser{Addr: &likeAddr{Zip: "1"}})
+	todo.Like(actual, likeUser{Tags: []string{"a"}})
+	todo.Like(actual, likeUser{Attrs: map[string]any{"age": 31}})
+	todo.Like(actual, likeUser{Attrs: map[string]any{"email": ""}})
+	todo.Like(actual, likeUser{Attrs: map[string]any{"age": check.Lt(18)}})
+	todo.Like(actual, likeUser{Created: now.Add(time.Second)})
+	todo.Like(actual, likeUser{extra: "y"})
+	todo.Like(actual, &actual)
+	todo.Like(42, 43)
+	todo.Like(nil, 42)
+	todo.Like([]any{1, "a"}, []any{nil, likeAddr{City: "Paris"}})
+
+	t.NotLike(actual, likeUser{Name: "Bob"})
+	todo.NotLike(actual, likeUser{Name: "Alice"})
+}
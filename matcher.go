@@ -0,0 +1,397 @@
+package check
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/powerman/check/internal/deepequal"
+)
+
+// Matcher may be used instead of a value anywhere inside expected value
+// of [TB.DeepEqual] (and other checkers comparing values like DeepEqual):
+// as expected itself or as an element of expected struct, map, slice or array -
+// in a place where it's allowed by Go type system
+// (i.e. having interface type like any, e.g. map[string]any or []any).
+// Instead of comparing actual value in this place for equality
+// it checks actual value using Match.
+//
+// String must return matcher description used in failure reports.
+type Matcher interface {
+	Match(actual any) bool
+	String() string
+}
+
+//nolint:gochecknoglobals // Const.
+var typMatcher = reflect.TypeFor[Matcher]()
+
+type matcher struct {
+	desc  string
+	match func(actual any) bool
+}
+
+func (m matcher) Match(actual any) bool { return m.match(actual) }
+func (m matcher) String() string        { return m.desc }
+
+// Any returns Matcher which matches any value (including nil).
+func Any() Matcher {
+	return matcher{"Any()", func(any) bool { return true }}
+}
+
+// NotZero returns Matcher which matches value which is not zero value
+// of it's type (see [TB.NotZero]).
+func NotZero() Matcher {
+	return matcher{"NotZero()", func(actual any) bool { return !isZero(actual) }}
+}
+
+// Re returns Matcher which matches value which matches regex
+// (see [TB.Match] about supported value and regex types).
+// Value of unsupported type doesn't match.
+//
+// It panics if regex is not a valid regular expression.
+func Re(regex any) Matcher {
+	var re *regexp.Regexp
+	switch v := regex.(type) {
+	case *regexp.Regexp:
+		re = v
+	case string:
+		re = regexp.MustCompile(v)
+	default:
+		panic("regex is not a *regexp.Regexp or string")
+	}
+	return matcher{fmt.Sprintf("Re(%q)", re), func(actual any) bool {
+		return stringify(&actual) && re.MatchString(actual.(string)) //nolint:forcetypeassert // False positive.
+	}}
+}
+
+// Gt returns Matcher which matches value > bound.
+//
+// Bound must be either a number, a string or [time.Time].
+// Numbers of different types are compared by their values,
+// value of other type than bound doesn't match.
+func Gt(bound any) Matcher {
+	return orderedMatcher("Gt", bound, func(cmp int) bool { return cmp > 0 })
+}
+
+// Ge returns Matcher which matches value >= bound.
+//
+// See Gt about supported bound types.
+func Ge(bound any) Matcher {
+	return orderedMatcher("Ge", bound, func(cmp int) bool { return cmp >= 0 })
+}
+
+// Lt returns Matcher which matches value < bound.
+//
+// See Gt about supported bound types.
+func Lt(bound any) Matcher {
+	return orderedMatcher("Lt", bound, func(cmp int) bool { return cmp < 0 })
+}
+
+// Le returns Matcher which matches value <= bound.
+//
+// See Gt about supported bound types.
+func Le(bound any) Matcher {
+	return orderedMatcher("Le", bound, func(cmp int) bool { return cmp <= 0 })
+}
+
+func orderedMatcher(name string, bound any, ok func(cmp int) bool) Matcher {
+	compareOrdered(bound, bound) // Panics on unsupported type.
+	return matcher{fmt.Sprintf("%s(%s)", name, matcherArg(bound)), func(actual any) bool {
+		cmp, comparable := compareOrdered(actual, bound)
+		return comparable && ok(cmp)
+	}}
+}
+
+// InRange returns Matcher which matches min <= value <= max.
+//
+// See Gt about supported min and max types.
+func InRange(minimum, maximum any) Matcher {
+	ge, le := Ge(minimum), Le(maximum)
+	return matcher{fmt.Sprintf("InRange(%s, %s)", matcherArg(minimum), matcherArg(maximum)), func(actual any) bool {
+		return ge.Match(actual) && le.Match(actual)
+	}}
+}
+
+// Len returns Matcher which matches value with len(value) == n.
+// Value which has no length doesn't match.
+func Len(n int) Matcher {
+	return matcher{fmt.Sprintf("Len(%d)", n), func(actual any) bool {
+		switch reflect.ValueOf(actual).Kind() { //nolint:exhaustive // Covered by default case.
+		case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+			return reflect.ValueOf(actual).Len() == n
+		default:
+			return false
+		}
+	}}
+}
+
+// AllOf returns Matcher which matches value matched by all of matchers.
+func AllOf(matchers ...Matcher) Matcher {
+	return matcher{"AllOf(" + joinMatchers(matchers) + ")", func(actual any) bool {
+		for _, m := range matchers {
+			if !m.Match(actual) {
+				return false
+			}
+		}
+		return true
+	}}
+}
+
+// AnyOf returns Matcher which matches value matched by any of matchers.
+func AnyOf(matchers ...Matcher) Matcher {
+	return matcher{"AnyOf(" + joinMatchers(matchers) + ")", func(actual any) bool {
+		for _, m := range matchers {
+			if m.Match(actual) {
+				return true
+			}
+		}
+		return false
+	}}
+}
+
+// Not returns Matcher which matches value not matched by m.
+func Not(m Matcher) Matcher {
+	return matcher{"Not(" + m.String() + ")", func(actual any) bool { return !m.Match(actual) }}
+}
+
+func joinMatchers(matchers []Matcher) string {
+	desc := make([]string, len(matchers))
+	for i, m := range matchers {
+		desc[i] = m.String()
+	}
+	return strings.Join(desc, ", ")
+}
+
+// matcherArg returns short description of v for matcher description or failure report.
+func matcherArg(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return fmt.Sprintf("%q", v)
+	case Matcher:
+		return v.String()
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// compareOrdered returns -1, 0 or +1 if actual is less, equal or greater than bound.
+// It returns false if they can't be compared.
+// It panics if bound is not a number, string or time.Time.
+func compareOrdered(actual, bound any) (cmp int, ok bool) {
+	if b, isNum := ratOf(bound); isNum {
+		a, isNum := ratOf(actual)
+		if !isNum {
+			return 0, false
+		}
+		return a.Cmp(b), true
+	}
+	switch b := bound.(type) {
+	case time.Time:
+		a, isTime := actual.(time.Time)
+		return a.Compare(b), isTime
+	default:
+		if reflect.ValueOf(bound).Kind() != reflect.String {
+			panic("bound is not a number, string or time.Time")
+		}
+		if reflect.TypeOf(actual) != reflect.TypeOf(bound) {
+			return 0, false
+		}
+		return strings.Compare(reflect.ValueOf(actual).String(), reflect.ValueOf(bound).String()), true
+	}
+}
+
+// ratOf returns exact value of v if it's a (non-NaN, non-Inf) number.
+func ratOf(v any) (*big.Rat, bool) {
+	switch val := reflect.ValueOf(v); val.Kind() { //nolint:exhaustive // Covered by default case.
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(val.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(val.Uint())), true
+	case reflect.Float32, reflect.Float64:
+		r := new(big.Rat).SetFloat64(val.Float())
+		return r, r != nil
+	default:
+		return nil, false
+	}
+}
+
+// hasMatcher returns true if expected is a Matcher or contains Matcher at any depth.
+func hasMatcher(expected any) bool {
+	return containsMatcher(reflect.ValueOf(expected), make(map[uintptr]bool))
+}
+
+func containsMatcher(v reflect.Value, visited map[uintptr]bool) bool {
+	if !v.IsValid() {
+		return false
+	}
+	if v.Kind() != reflect.Interface && v.Type().Implements(typMatcher) {
+		return true
+	}
+	switch v.Kind() { //nolint:exhaustive // Other kinds can't contain Matcher.
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if v.IsNil() || (v.Kind() != reflect.Slice && visited[v.Pointer()]) {
+			return false
+		}
+		if v.Kind() != reflect.Slice {
+			visited[v.Pointer()] = true
+		}
+	}
+	switch v.Kind() { //nolint:exhaustive // Other kinds can't contain Matcher.
+	case reflect.Interface, reflect.Pointer:
+		return containsMatcher(v.Elem(), visited)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if containsMatcher(v.Field(i), visited) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if !typeMayContainMatcher(v.Type().Elem()) {
+			return false
+		}
+		for i := range v.Len() {
+			if containsMatcher(v.Index(i), visited) {
+				return true
+			}
+		}
+	case reflect.Map:
+		if !typeMayContainMatcher(v.Type().Elem()) {
+			return false
+		}
+		for iter := v.MapRange(); iter.Next(); {
+			if containsMatcher(iter.Value(), visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// typeMayContainMatcher returns false if value of typ can't contain Matcher.
+func typeMayContainMatcher(typ reflect.Type) bool {
+	switch typ.Kind() { //nolint:exhaustive // Other kinds may contain Matcher.
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return typ.Implements(typMatcher)
+	}
+	return true
+}
+
+// matchDeep compares actual with expected like DeepEqual, but using Matcher
+// found in expected instead of comparing for equality.
+// It returns descriptions of all mismatches (with their paths).
+func matchDeep(actual, expected any) []string {
+	return matchValue(reflect.ValueOf(actual), reflect.ValueOf(expected), "")
+}
+
+func matchValue(actual, expected reflect.Value, path string) []string { //nolint:gocyclo,cyclop // By design.
+	for actual.Kind() == reflect.Interface && !actual.IsNil() {
+		actual = actual.Elem()
+	}
+	for expected.Kind() == reflect.Interface && !expected.IsNil() {
+		expected = expected.Elem()
+	}
+	mismatch := func(format string, args ...any) []string {
+		if path == "" {
+			path = "(root)"
+		}
+		return []string{path + ": " + fmt.Sprintf(format, args...)}
+	}
+
+	if expected.IsValid() && expected.Kind() != reflect.Interface && expected.Type().Implements(typMatcher) {
+		m := valueOf(expected).(Matcher) //nolint:forcetypeassert // False positive.
+		if !m.Match(valueOf(actual)) {
+			return mismatch("expected %s, got %s", m, matcherArg(valueOf(actual)))
+		}
+		return nil
+	}
+	if !containsMatcher(expected, make(map[uintptr]bool)) {
+		if !elemEqual(valueOf(actual), valueOf(expected), false) {
+			return mismatch("expected %s, got %s", matcherArg(valueOf(expected)), matcherArg(valueOf(actual)))
+		}
+		return nil
+	}
+	if !actual.IsValid() || actual.Type() != expected.Type() {
+		return mismatch("expected %s, got %s", expected.Type(), typeName(actual))
+	}
+
+	var mismatches []string
+	switch expected.Kind() { //nolint:exhaustive // Other kinds can't contain Matcher.
+	case reflect.Pointer:
+		if actual.IsNil() {
+			return mismatch("expected non-nil %s, got nil", expected.Type())
+		}
+		return matchValue(actual.Elem(), expected.Elem(), path)
+	case reflect.Struct:
+		for i := range expected.NumField() {
+			mismatches = append(mismatches,
+				matchValue(actual.Field(i), expected.Field(i), path+"."+expected.Type().Field(i).Name)...)
+		}
+	case reflect.Slice, reflect.Array:
+		if actual.Len() != expected.Len() {
+			return mismatch("expected %d elements, got %d", expected.Len(), actual.Len())
+		}
+		for i := range expected.Len() {
+			mismatches = append(mismatches, matchValue(actual.Index(i), expected.Index(i), fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case reflect.Map:
+		keys := slices.SortedFunc(func(yield func(reflect.Value) bool) {
+			for _, k := range expected.MapKeys() {
+				if !yield(k) {
+					return
+				}
+			}
+			for _, k := range actual.MapKeys() {
+				if !expected.MapIndex(k).IsValid() && !yield(k) {
+					return
+				}
+			}
+		}, func(a, b reflect.Value) int { return strings.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b)) })
+		for _, k := range keys {
+			keyPath := fmt.Sprintf("%s[%s]", path, matcherArg(valueOf(k)))
+			switch a, e := actual.MapIndex(k), expected.MapIndex(k); {
+			case !a.IsValid():
+				mismatches = append(mismatches, keyPath+": missing")
+			case !e.IsValid():
+				mismatches = append(mismatches, keyPath+": unexpected")
+			default:
+				mismatches = append(mismatches, matchValue(a, e, keyPath)...)
+			}
+		}
+	}
+	return mismatches
+}
+
+// valueOf returns value of v (or nil if v is invalid or nil interface),
+// even if v was obtained by accessing unexported struct fields.
+func valueOf(v reflect.Value) any {
+	if !v.IsValid() || (v.Kind() == reflect.Interface && v.IsNil()) {
+		return nil
+	}
+	return deepequal.Interface(v)
+}
+
+func typeName(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	return v.Type().String()
+}
+
+func explainMismatches(mismatches []string) func() string {
+	return func() string {
+		if len(mismatches) == 0 {
+			return ""
+		}
+		return "Mismatches:\n  " + strings.Join(mismatches, "\n  ") + "\n"
+	}
+}
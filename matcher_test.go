@@ -0,0 +1,120 @@
+package check_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/powerman/check"
+)
+
+type matcherUser struct {
+	ID      any
+	Name    string
+	Tags    []any
+	Attrs   map[string]any
+	Created any
+	secret  any
+}
+
+func TestMatcher(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+
+	now := time.Now()
+	cases := []struct {
+		m     check.Matcher
+		match []any
+		not   []any
+	}{
+		{check.Any(), []any{nil, 0, "", []int(nil), struct{}{}}, nil},
+		{check.NotZero(), []any{1, "a", []int{}, &struct{}{}}, []any{nil, 0, "", []int(nil), struct{}{}}},
+		{check.Re(`^a\d$`), []any{"a1", []byte("a2"), []rune("a3"), regexp.MustCompile("a4")}, []any{nil, "a", "ba1", 1}},
+		{check.Re(regexp.MustCompile(`a`)), []any{"a"}, []any{"b"}},
+		{check.Gt(0), []any{1, int8(1), uint(1), 0.5, float32(1e-9)}, []any{nil, 0, -1, uint(0), -0.5, "1", now}},
+		{check.Gt(-1), []any{uint(0), 0}, []any{-1, -2.5}},
+		{check.Gt(0.5), []any{1, 0.6}, []any{0, 0.5}},
+		{check.Ge(0), []any{0, 1, uint64(0)}, []any{-1, -0.1}},
+		{check.Lt(uint64(1 << 63)), []any{int64(-1), uint64(1<<63 - 1)}, []any{uint64(1 << 63)}},
+		{check.Le(0), []any{0, -1}, []any{1}},
+		{check.Gt("b"), []any{"c"}, []any{"b", "a", 'c', []byte("c")}},
+		{check.Lt(now), []any{now.Add(-time.Second)}, []any{now, now.Unix()}},
+		{check.InRange(1, 3), []any{1, 2.5, uint8(3)}, []any{0, 3.01, "2"}},
+		{check.InRange(now, now.Add(time.Minute)), []any{now, now.Add(time.Second)}, []any{now.Add(-time.Second)}},
+		{check.Len(2), []any{"ab", []int{1, 2}, map[int]int{1: 1, 2: 2}, [2]bool{}}, []any{nil, "a", 2, []int{}}},
+		{check.AllOf(check.Gt(0), check.Lt(10)), []any{1, 9}, []any{0, 10}},
+		{check.AllOf(), []any{nil, 1}, nil},
+		{check.AnyOf(check.Lt(0), check.Gt(10)), []any{-1, 11}, []any{0, 10}},
+		{check.AnyOf(), nil, []any{nil, 1}},
+		{check.Not(check.Re(`a`)), []any{"b", nil}, []any{"a"}},
+	}
+	for _, v := range cases {
+		for _, actual := range v.match {
+			t.True(v.m.Match(actual), v.m, actual)
+		}
+		for _, actual := range v.not {
+			t.False(v.m.Match(actual), v.m, actual)
+		}
+	}
+
+	t.Equal(check.Gt(0).String(), "Gt(0)")
+	t.Equal(check.InRange(0.5, "b").String(), `InRange(0.5, "b")`)
+	t.Equal(check.Not(check.AllOf(check.Re("^a"), check.Len(2))).String(), `Not(AllOf(Re("^a"), Len(2)))`)
+	t.PanicMatch(func() { check.Gt(nil) }, "bound is not a number, string or time.Time")
+	t.PanicMatch(func() { check.InRange([]int{}, 1) }, "bound is not a number")
+	t.PanicMatch(func() { check.Re(1) }, "regex is not")
+	t.Panic(func() { check.Re("(") })
+}
+
+func TestDeepEqualMatcher(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	actual := matcherUser{
+		ID:      42,
+		Name:    "Alice",
+		Tags:    []any{"a", "b"},
+		Attrs:   map[string]any{"age": 30, "email": "alice@example.com"},
+		Created: time.Now(),
+		secret:  "s3cr3t",
+	}
+	expected := matcherUser{
+		ID:      check.Gt(0),
+		Name:    "Alice",
+		Tags:    []any{check.Any(), "b"},
+		Attrs:   map[string]any{"age": check.InRange(18, 99), "email": check.Re(`@`)},
+		Created: check.NotZero(),
+		secret:  check.Len(6),
+	}
+	t.DeepEqual(actual, expected)
+	t.DeepEqual(&actual, &expected)
+	t.DeepEqual(actual.ID, check.Gt(0))
+	t.DeepEqual(nil, check.Any())
+	t.DeepEqual([]any{1, []any{2, 3}}, []any{1, []any{2, check.Gt(2)}})
+	t.DeepEqual(map[string]any{"a": map[string]any{"b": 1}}, map[string]any{"a": map[string]any{"b": check.Gt(0)}})
+	todo.NotDeepEqual(actual, expected)
+	t.NotDeepEqual(actual.ID, check.Lt(0))
+
+	todo.DeepEqual(actual.ID, check.Lt(0))
+	todo.DeepEqual(nil, check.NotZero())
+	todo.DeepEqual(&actual, &matcherUser{ID: check.Any()})
+	todo.DeepEqual(actual, matcherUser{ID: check.Lt(0), Name: "Alice", Tags: actual.Tags, Attrs: actual.Attrs, Created: actual.Created, secret: actual.secret})
+	todo.DeepEqual(actual, matcherUser{ID: check.Gt(0), Name: "Bob", Tags: actual.Tags, Attrs: actual.Attrs, Created: actual.Created, secret: actual.secret})
+	todo.DeepEqual([]any{1, 2}, []any{check.Any()})
+	todo.DeepEqual([]int{1, 2}, []any{check.Any(), check.Any()})
+	todo.DeepEqual((*matcherUser)(nil), &expected)
+	todo.DeepEqual(map[string]any{"a": 1, "c": 3}, map[string]any{"a": check.Any(), "b": check.Any()})
+	t.NotDeepEqual(map[string]any{"a": 1, "c": 3}, map[string]any{"a": check.Any(), "b": check.Any()})
+
+	// Matchers work in other checkers comparing values like DeepEqual.
+	t.SortEqual([]any{"b", 1}, []any{check.Gt(0), check.Re("b")})
+	t.Subset(map[string]any{"a": 1, "b": 2}, map[string]any{"a": check.Gt(0)})
+	ch := make(chan int, 1)
+	ch <- 1
+	t.Receive(ch, check.Gt(0))
+	// Matchers are looked for only in expected.
+	t.Unique([]any{2, check.Gt(1)})
+	todo.SortEqual([]any{check.Gt(0)}, []any{1})
+	todo.Subset([]any{check.Gt(0)}, []any{1})
+}
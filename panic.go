@@ -0,0 +1,82 @@
+package check
+
+import (
+	"errors"
+	"runtime/debug"
+	"strings"
+)
+
+// panicked describes result of calling a func which may panic.
+type panicked struct {
+	didPanic bool
+	value    any    // Recovered value.
+	stack    []byte // Goroutine stack captured at recover time.
+}
+
+// catchPanic calls f and recovers a panic (if any).
+//
+// It is able to detect panic(nil).
+func catchPanic(f func()) (p panicked) {
+	p.didPanic = true
+	defer func() {
+		if p.didPanic {
+			p.value = recover()
+			p.stack = debug.Stack()
+		}
+	}()
+	f()
+	p.didPanic = false
+	return p
+}
+
+// explainStack returns a stack of a panic to be shown in a failure report,
+// without frames of catchPanic itself.
+func (p panicked) explainStack() string {
+	lines := strings.Split(string(p.stack), "\n")
+	for i := 1; i+1 < len(lines); i += 2 {
+		if strings.HasPrefix(lines[i], "panic(") {
+			lines = append(lines[:1], lines[i:]...)
+			break
+		}
+	}
+	return explainText("Stack", strings.Join(lines, "\n"))
+}
+
+// PanicValue checks is actual() panics with a value which is
+// the same as expected (like DeepEqual).
+//
+// In case of panic(nil) a value is [*runtime.PanicNilError]
+// (unless GODEBUG=panicnil=1 is set).
+func (t *checks) PanicValue(actual func(), expected any, msg ...any) bool {
+	t.tb.Helper()
+	p := catchPanic(actual)
+	if !p.didPanic {
+		return t.report2(note("no panic"), expected, msg,
+			false)
+	}
+	ok, explanation := isDeepEqual(p.value, expected)
+	return t.report2Explained(p.value, expected, msg,
+		ok, explanation)
+}
+
+// PanicErrIs checks is actual() panics with an error value
+// and [errors.Is](value, target).
+//
+// On failure it shows a panic value, which is an error, as a tree
+// of wrapped errors with errors of same type as target marked.
+func (t *checks) PanicErrIs(actual func(), target error, msg ...any) bool {
+	t.tb.Helper()
+	p := catchPanic(actual)
+	if !p.didPanic {
+		return t.report2(note("no panic"), target, msg,
+			false)
+	}
+	err, isErr := p.value.(error)
+	ok := isErr && errors.Is(err, target)
+	value := p.value
+	if isErr {
+		value = t.errArg(ok, err, markSameType(target))
+	}
+	return t.report2(value, target, msg,
+		ok)
+}
@@ -0,0 +1,75 @@
+package check_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+func TestPanicValue(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	todo.PanicValue(func() {}, nil)
+	t.PanicValue(func() { panic("oops") }, "oops")
+	todo.PanicValue(func() { panic("oops") }, "Oops")
+	t.PanicValue(func() { panic(42) }, 42)
+	todo.PanicValue(func() { panic(42) }, int64(42))
+	t.PanicValue(func() { panic([]int{1, 2}) }, []int{1, 2})
+	t.PanicValue(func() { panic(io.EOF) }, io.EOF)
+	t.PanicValue(func() { panic(map[string]any{"N": 3}) }, map[string]any{"N": check.Gt(2)})
+	todo.PanicValue(func() { panic(map[string]any{"N": 3}) }, map[string]any{"N": check.Gt(3)})
+	var nilErr *runtime.PanicNilError
+	t.PanicValue(func() { panic(nil) }, new(runtime.PanicNilError)) //nolint:govet // Testing nil panic.
+	todo.PanicValue(func() { panic(nil) }, nilErr)                  //nolint:govet // Testing nil panic.
+}
+
+func TestPanicErrIs(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	todo.PanicErrIs(func() {}, io.EOF)
+	t.PanicErrIs(func() { panic(io.EOF) }, io.EOF)
+	t.PanicErrIs(func() { panic(fmt.Errorf("read: %w", io.EOF)) }, io.EOF)
+	t.PanicErrIs(func() { panic(errors.Join(io.ErrClosedPipe, io.EOF)) }, io.EOF)
+	todo.PanicErrIs(func() { panic(io.EOF) }, io.ErrUnexpectedEOF)
+	todo.PanicErrIs(func() { panic("EOF") }, io.EOF)
+	todo.PanicErrIs(func() { panic(nil) }, io.EOF) //nolint:govet // Testing nil panic.
+}
+
+func TestPanicReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakePanicReport"}
+	t := check.New(fake)
+
+	t.NotPanic(func() {})
+	t.NotPanic(func() { panic("oops") })
+	t.PanicValue(func() { panic(42) }, 43)
+	t.PanicValue(func() {}, 42)
+	t.PanicErrIs(func() { panic(fmt.Errorf("read: %w", io.EOF)) }, io.ErrUnexpectedEOF)
+	t.PanicErrIs(func() { panic("EOF") }, io.EOF)
+	t.PanicErrIs(func() {}, io.EOF)
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 6)
+	realT.Contains(fake.msgs[0], "Checker:  NotPanic\n")
+	realT.Contains(fake.msgs[0], "Panic:    (string) (len=4) 'oops'\n")
+	realT.Match(fake.msgs[0], `(?s)\nStack:\n  goroutine \d+ \[running\]:\n  panic\(.*check_test\.TestPanicReport\.func2\(\)\n`)
+	realT.Contains(fake.msgs[1], "Checker:  PanicValue\n")
+	realT.Contains(fake.msgs[1], "Expected: (int) 43\nActual:   (int) 42\n")
+	realT.Contains(fake.msgs[2], "Checker:  PanicValue\n")
+	realT.Contains(fake.msgs[2], "Expected: (int) 42\nActual:   no panic\n")
+	realT.Contains(fake.msgs[3], "Checker:  PanicErrIs\n")
+	realT.Contains(fake.msgs[3], `Actual:   *fmt.wrapError: "read: EOF"`+"\n"+
+		`          └─ *errors.errorString: "EOF"  ← same type as expected`+"\n")
+	realT.Contains(fake.msgs[4], "Checker:  PanicErrIs\n")
+	realT.Contains(fake.msgs[4], "Actual:   (string) (len=3) 'EOF'\n")
+	realT.Contains(fake.msgs[5], "Checker:  PanicErrIs\n")
+	realT.Contains(fake.msgs[5], "Expected: (*errors.errorString)(EOF)\nActual:   no panic\n")
+}
@@ -0,0 +1,184 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Sorted checks that actual is sorted in non-decreasing order,
+// i.e. actual[i] <= actual[i+1] for every i.
+//
+// Actual must be a slice or array of either:
+//   - signed integers
+//   - unsigned integers
+//   - floats
+//   - strings
+//   - [time.Time]
+//
+// On failure it shows the first pair of elements which violates the order.
+func (t *checks) Sorted(actual any, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportOrdered(actual, msg, "Not sorted",
+		func(a, b any) bool { return !isGreater(a, b) })
+}
+
+// SortedFunc checks that actual is sorted in non-decreasing order
+// defined by cmp.
+//
+// Actual must be a slice or array, cmp must be either:
+//   - func(a, b E) int - like in [slices.SortFunc]
+//   - func(E) K        - returns a key to compare using <=,
+//     which must have any type supported by [TB.Sorted]
+//
+// On failure it shows the first pair of elements which violates the order.
+func (t *checks) SortedFunc(actual, cmp any, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportOrdered(actual, msg, "Not sorted",
+		sortedFunc(cmp))
+}
+
+func sortedFunc(cmp any) func(a, b any) bool {
+	f := reflect.ValueOf(cmp)
+	if f.Kind() == reflect.Func && f.Type().NumOut() == 1 {
+		call := func(args ...any) reflect.Value {
+			in := make([]reflect.Value, len(args))
+			for i, arg := range args {
+				in[i] = reflect.ValueOf(arg)
+				if !in[i].IsValid() { // Nil element of []any, []error, etc.
+					in[i] = reflect.Zero(f.Type().In(i))
+				}
+			}
+			return f.Call(in)[0]
+		}
+		switch f.Type().NumIn() {
+		case 1:
+			return func(a, b any) bool { return !isGreater(call(a).Interface(), call(b).Interface()) }
+		case 2: //nolint:mnd // Two args.
+			if f.Type().Out(0).Kind() == reflect.Int {
+				return func(a, b any) bool { return call(a, b).Int() <= 0 }
+			}
+		}
+	}
+	panic("cmp is not a func(a, b E) int or func(E) K")
+}
+
+// Increasing checks that actual is sorted in strictly increasing order,
+// i.e. actual[i] < actual[i+1] for every i.
+//
+// See Sorted about supported actual types.
+func (t *checks) Increasing(actual any, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportOrdered(actual, msg, "Not increasing",
+		isLess)
+}
+
+// NonDecreasing checks that actual is sorted in non-decreasing order,
+// i.e. actual[i] <= actual[i+1] for every i.
+//
+// It is same as Sorted.
+// See Sorted about supported actual types.
+func (t *checks) NonDecreasing(actual any, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportOrdered(actual, msg, "Decreasing",
+		func(a, b any) bool { return !isGreater(a, b) })
+}
+
+// Decreasing checks that actual is sorted in strictly decreasing order,
+// i.e. actual[i] > actual[i+1] for every i.
+//
+// See Sorted about supported actual types.
+func (t *checks) Decreasing(actual any, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportOrdered(actual, msg, "Not decreasing",
+		isGreater)
+}
+
+// NonIncreasing checks that actual is sorted in non-increasing order,
+// i.e. actual[i] >= actual[i+1] for every i.
+//
+// See Sorted about supported actual types.
+func (t *checks) NonIncreasing(actual any, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportOrdered(actual, msg, "Increasing",
+		func(a, b any) bool { return !isLess(a, b) })
+}
+
+// reportOrdered reports result of checking ordered for each pair of
+// adjacent elements of actual, explaining the first failed pair.
+func (c *checks) reportOrdered(actual any, msg []any, title string, ordered func(a, b any) bool) bool {
+	c.tb.Helper()
+	val := sequence(actual)
+	i := 0
+	for ; i+1 < val.Len(); i++ {
+		if !ordered(val.Index(i).Interface(), val.Index(i+1).Interface()) {
+			break
+		}
+	}
+	ok := i+1 >= val.Len()
+	return c.reportExplained(ok, msg,
+		callerFuncName(1),
+		[]string{nameActual},
+		[]any{actual},
+		func() string {
+			if i+1 >= val.Len() {
+				return ""
+			}
+			return explain(fmt.Sprintf("%s at [%d] and [%d]", title, i, i+1),
+				[]string{fmt.Sprintf("[%d]", i), fmt.Sprintf("[%d]", i+1)},
+				[]any{val.Index(i).Interface(), val.Index(i + 1).Interface()})
+		})
+}
+
+// Unique checks that actual has no duplicate elements.
+//
+// Actual must be a slice or array.
+// Elements are compared like DeepEqual.
+//
+// On failure it shows all duplicates with index of their first occurrence.
+func (t *checks) Unique(actual any, msg ...any) bool {
+	t.tb.Helper()
+	dups := duplicates(sequence(actual))
+	return t.report1Explained(actual, msg, len(dups) == 0,
+		explainDuplicates(actual, dups))
+}
+
+// NoDuplicates is a synonym for Unique.
+func (t *checks) NoDuplicates(actual any, msg ...any) bool {
+	t.tb.Helper()
+	return t.Unique(actual, msg...)
+}
+
+// duplicates returns pairs of indices of duplicate element and its first occurrence.
+func duplicates(val reflect.Value) [][2]int {
+	var dups [][2]int
+	for j := range val.Len() {
+		for i := range j {
+			if elemEqual(val.Index(j).Interface(), val.Index(i).Interface(), false) {
+				dups = append(dups, [2]int{i, j})
+				break
+			}
+		}
+	}
+	return dups
+}
+
+func explainDuplicates(actual any, dups [][2]int) func() string {
+	return func() string {
+		val := sequence(actual)
+		name := make([]string, len(dups))
+		values := make([]any, len(dups))
+		for k, dup := range dups {
+			name[k] = fmt.Sprintf("[%d] == [%d]", dup[1], dup[0])
+			values[k] = val.Index(dup[1]).Interface()
+		}
+		return explain("Duplicates", name, values)
+	}
+}
+
+func sequence(actual any) reflect.Value {
+	val := reflect.ValueOf(actual)
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		panic("actual is not a slice or array")
+	}
+	return val
+}
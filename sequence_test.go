@@ -0,0 +1,135 @@
+package check_test
+
+import (
+	"cmp"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/powerman/check"
+)
+
+func TestCheckerSorted(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.Sorted([]int{})
+	t.Sorted([]int(nil))
+	t.Sorted([]int{1})
+	t.Sorted([]int{1, 2, 2, 3})
+	todo.Sorted([]int{1, 3, 2})
+	t.Sorted([3]string{"a", "b", "c"})
+	todo.Sorted([]string{"b", "a"})
+	t.Sorted([]float64{-1.5, 0, 2.5})
+	t.Sorted([]uint8{1, 2, 3})
+	t.Sorted([]time.Time{xTime, xTime.Add(time.Second)})
+	todo.Sorted([]time.Time{xTime.Add(time.Second), xTime})
+
+	t.NonDecreasing([]int{1, 2, 2, 3})
+	todo.NonDecreasing([]int{1, 3, 2})
+	t.Increasing([]int{1, 2, 3})
+	todo.Increasing([]int{1, 2, 2, 3})
+	t.NonIncreasing([]int{3, 2, 2, 1})
+	todo.NonIncreasing([]int{3, 1, 2})
+	t.Decreasing([]int{3, 2, 1})
+	todo.Decreasing([]int{3, 2, 2, 1})
+
+	t.PanicMatch(func() { t.Sorted(42) }, "actual is not a slice or array")
+	t.PanicMatch(func() { t.Increasing(nil) }, "actual is not a slice or array")
+	t.PanicMatch(func() { t.Sorted([]bool{true, false}) }, "actual is not a number, string or time.Time")
+}
+
+func TestCheckerSortedFunc(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"bob", 20}, {"alice", 30}, {"carol", 30}}
+
+	t.SortedFunc(users, func(a, b user) int { return cmp.Compare(a.age, b.age) })
+	todo.SortedFunc(users, func(a, b user) int { return strings.Compare(a.name, b.name) })
+	t.SortedFunc(users, func(u user) int { return u.age })
+	todo.SortedFunc(users, func(u user) string { return u.name })
+	t.SortedFunc([]user{}, func(u user) string { return u.name })
+
+	type ordering int
+	byAge := func(a, b user) ordering { return ordering(cmp.Compare(a.age, b.age)) }
+	t.SortedFunc(users, byAge)
+	todo.SortedFunc([]user{{"x", 2}, {"y", 1}}, byAge)
+
+	nilFirst := func(a, b error) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		case b == nil:
+			return 1
+		}
+		return strings.Compare(a.Error(), b.Error())
+	}
+	t.SortedFunc([]error{nil, nil, io.EOF}, nilFirst)
+	todo.SortedFunc([]error{io.EOF, nil}, nilFirst)
+	isSet := func(v any) int {
+		if v == nil {
+			return 0
+		}
+		return 1
+	}
+	t.SortedFunc([]any{nil, 1}, isSet)
+	todo.SortedFunc([]any{1, nil}, isSet)
+
+	t.PanicMatch(func() { t.SortedFunc(users, 42) }, "cmp is not a func")
+	t.PanicMatch(func() { t.SortedFunc(users, func(a, b user) bool { return true }) }, "cmp is not a func")
+	t.PanicMatch(func() { t.SortedFunc(users, func() int { return 0 }) }, "cmp is not a func")
+}
+
+func TestCheckerUnique(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	type point struct{ x, y int }
+
+	t.Unique([]int{})
+	t.Unique([]int{1, 2, 3})
+	todo.Unique([]int{1, 2, 1})
+	t.NoDuplicates([2]string{"a", "b"})
+	todo.NoDuplicates([]string{"a", "b", "a", "a"})
+	t.Unique([]point{{1, 2}, {2, 1}})
+	todo.Unique([]point{{1, 2}, {1, 2}})
+	t.Unique([]any{1, "1", 1.0})
+	todo.Unique([]time.Time{xTime, xTimeEST})
+
+	t.PanicMatch(func() { t.Unique(map[int]int{}) }, "actual is not a slice or array")
+}
+
+func TestSortedTODOReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeSortedTODOReport"}
+	todo := check.New(fake).TODO()
+
+	for _, v := range []any{[]int{}, []int{1}, [0]string{}} {
+		todo.Sorted(v)
+		todo.NonDecreasing(v)
+		todo.Increasing(v)
+		todo.NonIncreasing(v)
+		todo.Decreasing(v)
+		todo.SortedFunc(v, func(a, b any) int { return 0 })
+	}
+	todo.Sorted([]int{1, 2, 3})
+	todo.Decreasing([]int{3, 2, 1})
+	todo.SortedFunc([]int{1, 2, 3}, func(a, b int) int { return cmp.Compare(a, b) })
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 21)
+	for _, msg := range fake.msgs {
+		realT.NotContains(msg, " at [")
+	}
+}
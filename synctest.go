@@ -0,0 +1,84 @@
+package check
+
+import (
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/powerman/check/internal/contextx"
+)
+
+// Synctest runs f in a new [testing/synctest] bubble using [synctest.Test].
+//
+// Unlike plain [synctest.Test] it provides f with a ready to use *TB,
+// which wraps the bubble's own [*testing.T] and inherits t's mode
+// (see [TB.TODO], [TB.MustAll], [Must] and [TB.Timeout])
+// and statistics (checks are counted for t's test).
+// Values of a context merged by [TB.MergeContext] are available in f's t.Context(),
+// but its cancellation is not: the bubble's goroutines can't be durably blocked
+// waiting for a context created outside the bubble.
+//
+// It panics if t doesn't wrap [*testing.T].
+func (t *TB) Synctest(f func(t *TB)) {
+	t.Helper()
+	tt, ok := t.TB.(*testing.T)
+	if !ok {
+		panic("Synctest requires *testing.T")
+	}
+	synctest.Test(tt, func(tt *testing.T) {
+		f(&TB{TB: tt, checks: t.inBubble(tt)})
+	})
+}
+
+// Synctest is like [TB.Synctest], but keeps working with *C and [*testing.T].
+//
+// Just like [ShouldFunc1]/[ShouldFunc2] callbacks f always receives a *[TB] (never *C).
+func (t *C) Synctest(f func(t *TB)) {
+	t.Helper()
+	synctest.Test(t.T, func(tt *testing.T) {
+		f(&TB{TB: tt, checks: t.inBubble(tt)})
+	})
+}
+
+// inBubble returns a *checks derived from c for use with tb inside synctest bubble.
+func (c *checks) inBubble(tb testing.TB) *checks {
+	d := *c
+	d.tb = tb
+	if c.statsTB == nil {
+		d.statsTB = c.tb
+	}
+	if c.ctx != nil {
+		d.ctx = contextx.MergeValues(tb.Context(), c.ctx)
+	}
+	return &d
+}
+
+// Wait waits until all other goroutines in the current synctest bubble
+// are durably blocked (see [synctest.Wait]) and returns t,
+// to make it easy to check results of their work:
+//
+//	t.Synctest(func(t *check.TB) {
+//		go worker()
+//		t.Wait().Equal(counter.Load(), 1)
+//	})
+//
+// It panics if called outside of a synctest bubble (e.g. outside [TB.Synctest]).
+func (t *TB) Wait() *TB {
+	synctest.Wait()
+	return t
+}
+
+// Advance advances fake time of the current synctest bubble by d
+// (by sleeping for d), then waits like [TB.Wait] and returns t:
+//
+//	t.Synctest(func(t *check.TB) {
+//		go func() { time.Sleep(time.Minute); close(done) }()
+//		t.Advance(time.Minute).Closed(done)
+//	})
+//
+// It panics if called outside of a synctest bubble (e.g. outside [TB.Synctest]).
+func (t *TB) Advance(d time.Duration) *TB {
+	time.Sleep(d)
+	synctest.Wait()
+	return t
+}
@@ -0,0 +1,62 @@
+package check_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/powerman/check"
+)
+
+func TestSynctest(tt *testing.T) {
+	tt.Parallel()
+	t := check.Must(tt)
+
+	t.Synctest(func(t *check.TB) {
+		var counter atomic.Int32
+		go func() {
+			time.Sleep(time.Hour)
+			counter.Add(1)
+		}()
+		t.Wait().Equal(counter.Load(), int32(0))
+		t.Advance(time.Hour).Equal(counter.Load(), int32(1))
+
+		done := make(chan struct{})
+		go func() {
+			time.Sleep(time.Minute)
+			close(done)
+		}()
+		t.Timeout(time.Second).NotReceive(done)
+		t.Closed(done)
+	})
+}
+
+func TestSynctestInherits(tt *testing.T) {
+	tt.Parallel()
+
+	type key struct{}
+
+	t := check.New(tt)
+	t.MergeContext(context.WithValue(t.Context(), key{}, "value")).Synctest(func(t *check.TB) {
+		t.Equal(t.Context().Value(key{}), "value")
+		t.Nil(t.Context().Err())
+	})
+	t.TODO().Synctest(func(t *check.TB) {
+		t.True(false)
+	})
+
+	check.T(tt).Synctest(func(t *check.TB) {
+		t.Equal(t.Name(), tt.Name())
+		t.Timeout(time.Minute).TODO().Receive(make(chan int), 0)
+	})
+}
+
+func TestSynctestPanic(tt *testing.T) {
+	tt.Parallel()
+	t := check.New(tt)
+
+	fake := &fakeTB{name: "fakeSynctestPanic"}
+	t.PanicMatch(func() { check.New(fake).Synctest(func(*check.TB) {}) }, "requires \\*testing.T")
+	t.Panic(func() { t.Wait() })
+}
@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/powerman/check/internal/contextx"
 )
@@ -32,9 +33,16 @@ import (
 type checks struct {
 	tb testing.TB
 
-	todo bool
-	must bool
-	ctx  context.Context // Non-nil only after MergeContext.
+	todo           bool
+	must           bool
+	useNumber      bool
+	ignoreXMLSpace bool
+	equalNaN       bool
+	fieldErrorKeys []FieldErrorKey // Non-nil only after FieldErrorKeys.
+	ctx            context.Context // Non-nil only after MergeContext.
+	timeout        time.Duration   // Non-zero only after Timeout.
+	statsTB        testing.TB      // Non-nil only inside Synctest: collect statistics for outer test.
+	noStats        bool            // True only inside Try: do not collect statistics.
 }
 
 func (c *checks) withTODO() *checks {
@@ -49,6 +57,39 @@ func (c *checks) withMustAll() *checks {
 	return &d
 }
 
+func (c *checks) withUseNumber() *checks {
+	d := *c
+	d.useNumber = true
+	return &d
+}
+
+func (c *checks) withIgnoreXMLSpace() *checks {
+	d := *c
+	d.ignoreXMLSpace = true
+	return &d
+}
+
+func (c *checks) withEqualNaN() *checks {
+	d := *c
+	d.equalNaN = true
+	return &d
+}
+
+func (c *checks) withFieldErrorKeys(keys []FieldErrorKey) *checks {
+	d := *c
+	d.fieldErrorKeys = validFieldErrorKeys(keys)
+	return &d
+}
+
+func (c *checks) withTimeout(timeout time.Duration) *checks {
+	if timeout <= 0 {
+		panic("timeout is not positive")
+	}
+	d := *c
+	d.timeout = timeout
+	return &d
+}
+
 // context returns the context associated with c:
 // the one merged in by the most recent MergeContext call if any, otherwise tb's own Context().
 func (c *checks) context() context.Context {
@@ -72,32 +113,53 @@ func (c *checks) mergeContext(ctx context.Context) (*checks, context.CancelFunc)
 	return &d, cancel
 }
 
+// stat returns statistics collected for c.
+// It must be called with statsMu locked.
+func (c *checks) stat() *testStat {
+	tb := c.tb
+	if c.statsTB != nil {
+		tb = c.statsTB
+	}
+	if stats[tb] == nil {
+		stats[tb] = newTestStat(tb.Name(), false)
+	}
+	return stats[tb]
+}
+
 func (c *checks) pass() {
+	if c.noStats {
+		return
+	}
 	statsMu.Lock()
 	defer statsMu.Unlock()
 
-	if stats[c.tb] == nil {
-		stats[c.tb] = newTestStat(c.tb.Name(), false)
-	}
 	if c.todo {
-		stats[c.tb].forged.value++
+		c.stat().forged.value++
 	} else {
-		stats[c.tb].passed.value++
+		c.stat().passed.value++
 	}
 }
 
 func (c *checks) fail() {
+	if c.noStats {
+		return
+	}
 	statsMu.Lock()
 	defer statsMu.Unlock()
 
-	if stats[c.tb] == nil {
-		stats[c.tb] = newTestStat(c.tb.Name(), false)
-	}
-	stats[c.tb].failed.value++
+	c.stat().failed.value++
 }
 
 func (c *checks) report(ok bool, msg []any, checker string, name []string, args []any) bool { //nolint:revive // False positive.
 	c.tb.Helper()
+	return c.reportExplained(ok, msg, checker, name, args, nil)
+}
+
+// reportExplained is like report, but in case of failure also shows
+// an explanation returned by explanation (if it's not nil) under the dumps.
+// Use explain to make an explanation.
+func (c *checks) reportExplained(ok bool, msg []any, checker string, name []string, args []any, explanation func() string) bool { //nolint:revive // False positive.
+	c.tb.Helper()
 
 	if ok != c.todo {
 		c.pass()
@@ -134,6 +196,9 @@ func (c *checks) report(ok bool, msg []any, checker string, name []string, args
 	if wantDiff {
 		fmt.Fprintf(failure, "\n%s", colouredDiff(dump[0].diff(dump[1])))
 	}
+	if explanation != nil {
+		fmt.Fprintf(failure, "\n%s", explanation())
+	}
 	c.tb.Errorf("%s\n", failure)
 
 	c.fail()
@@ -176,6 +241,15 @@ func (c *checks) report1(actual any, msg []any, ok bool) bool {
 		[]any{actual})
 }
 
+func (c *checks) report1Explained(actual any, msg []any, ok bool, explanation func() string) bool {
+	c.tb.Helper()
+	return c.reportExplained(ok, msg,
+		callerFuncName(1),
+		[]string{nameActual},
+		[]any{actual},
+		explanation)
+}
+
 func (c *checks) report2(actual, expected any, msg []any, ok bool) bool {
 	c.tb.Helper()
 	checker, arg2Name := callerFuncName(1), nameExpected
@@ -188,6 +262,15 @@ func (c *checks) report2(actual, expected any, msg []any, ok bool) bool {
 		[]any{actual, expected})
 }
 
+func (c *checks) report2Explained(actual, expected any, msg []any, ok bool, explanation func() string) bool {
+	c.tb.Helper()
+	return c.reportExplained(ok, msg,
+		callerFuncName(1),
+		[]string{nameActual, nameExpected},
+		[]any{actual, expected},
+		explanation)
+}
+
 func (c *checks) report3(actual, expected1, expected2 any, msg []any, ok bool) bool {
 	c.tb.Helper()
 	checker, arg2Name, arg3Name := callerFuncName(1), "arg1", "arg2"
@@ -198,6 +281,12 @@ func (c *checks) report3(actual, expected1, expected2 any, msg []any, ok bool) b
 		arg2Name, arg3Name = nameExpected, "Delta"
 	case strings.Contains(checker, "SMAPE"):
 		arg2Name, arg3Name = nameExpected, "SMAPE"
+	case strings.Contains(checker, "Epsilon"):
+		arg2Name, arg3Name = nameExpected, "Epsilon"
+	case strings.Contains(checker, "ULP"):
+		arg2Name, arg3Name = nameExpected, "ULPs"
+	case strings.Contains(checker, "ErrMatch"):
+		arg2Name, arg3Name = nameExpected, "Regex"
 	}
 	return c.report(ok, msg,
 		checker,
@@ -334,6 +423,52 @@ func (t *TB) MustAll() *TB {
 	return &TB{TB: t.TB, checks: t.withMustAll()}
 }
 
+// Timeout creates and returns new *TB, which have only one difference from original one:
+// checkers which have to wait for something (like [TB.Receive])
+// will give up after timeout (in addition to t.Context() being done).
+// You can continue using both old and new *TB at same time.
+//
+// It panics if timeout is not positive.
+func (t *TB) Timeout(timeout time.Duration) *TB {
+	return &TB{TB: t.TB, checks: t.withTimeout(timeout)}
+}
+
+// UseNumber creates and returns new *TB, which have only one difference from original one:
+// JSON checkers (like [TB.JSONEqual]) will keep precision of numbers
+// instead of converting them to float64 before comparison
+// (so large int64 IDs won't be silently rounded).
+// You can continue using both old and new *TB at same time.
+func (t *TB) UseNumber() *TB {
+	return &TB{TB: t.TB, checks: t.withUseNumber()}
+}
+
+// IgnoreXMLSpace creates and returns new *TB, which have only one difference from original one:
+// [TB.XMLEqual] will ignore whitespace-only text between elements
+// (e.g. indentation).
+// You can continue using both old and new *TB at same time.
+func (t *TB) IgnoreXMLSpace() *TB {
+	return &TB{TB: t.TB, checks: t.withIgnoreXMLSpace()}
+}
+
+// EqualNaN creates and returns new *TB, which have only one difference from original one:
+// tolerance checkers (like [TB.InDelta] and [TB.InEpsilon]) will consider
+// NaN equal to NaN (by default NaN isn't close to anything).
+// You can continue using both old and new *TB at same time.
+func (t *TB) EqualNaN() *TB {
+	return &TB{TB: t.TB, checks: t.withEqualNaN()}
+}
+
+// FieldErrorKeys creates and returns new *TB, which have only one difference from original one:
+// error checkers (like [TB.Err] and [TB.ErrExactly]) will compare
+// validator.FieldError-like errors by given parts (using [FieldErrorChecker])
+// before trying registered error checkers.
+// You can continue using both old and new *TB at same time.
+//
+// It panics if keys are empty or contain an unknown key.
+func (t *TB) FieldErrorKeys(keys ...FieldErrorKey) *TB {
+	return &TB{TB: t.TB, checks: t.withFieldErrorKeys(keys)}
+}
+
 // Context returns the context associated with t:
 // the context merged in by the most recent [TB.MergeContext] call if any,
 // otherwise the standard [testing.TB.Context]().
@@ -0,0 +1,357 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// InEpsilon checks that relative error |actual-expected|/|expected|
+// is less than or equal to epsilon.
+//
+// Both actual and expected must be either:
+//   - signed integers
+//   - unsigned integers
+//   - floats
+//   - complex numbers (error is a distance between them in the complex plane)
+//
+// Epsilon must be >= 0.
+// If expected is 0 then actual must be 0 too.
+//
+// NaN isn't close to anything (use [TB.EqualNaN] to make NaN close to NaN),
+// +Inf and -Inf are close only to themselves.
+func (t *checks) InEpsilon(actual, expected any, epsilon float64, msg ...any) bool {
+	t.tb.Helper()
+	return t.report3(actual, expected, epsilon, msg,
+		isInEpsilon(actual, expected, epsilon, t.equalNaN))
+}
+
+func isInEpsilon(actual, expected any, epsilon float64, equalNaN bool) bool {
+	if !(epsilon >= 0) {
+		panic("epsilon is not >= 0")
+	}
+	a, e := complexOf(actual, "actual"), complexOf(expected, "expected")
+	return isTolerated(a, e, equalNaN, func() bool {
+		return e != 0 && cmplx.Abs(a-e)/cmplx.Abs(e) <= epsilon
+	})
+}
+
+// NotInEpsilon checks that relative error |actual-expected|/|expected|
+// is greater than epsilon.
+//
+// See InEpsilon about supported actual/expected types and check logic.
+func (t *checks) NotInEpsilon(actual, expected any, epsilon float64, msg ...any) bool {
+	t.tb.Helper()
+	return t.report3(actual, expected, epsilon, msg,
+		!isInEpsilon(actual, expected, epsilon, t.equalNaN))
+}
+
+// InULP checks that actual and expected differs in at most ulps
+// units in the last place, i.e. there are at most ulps-1
+// representable floats between them.
+//
+// Both actual and expected must be either float32, float64 (both
+// are converted to the type of actual), complex64 or complex128
+// (real and imaginary parts are checked separately).
+// ULP of float32 is much larger than of float64, so it's important to
+// check float32 values without converting them to float64.
+//
+// Zero differs from negative zero in 0 ulps.
+// NaN isn't close to anything (use [TB.EqualNaN] to make NaN close to NaN),
+// +Inf and -Inf are close only to themselves.
+func (t *checks) InULP(actual, expected any, ulps uint64, msg ...any) bool {
+	t.tb.Helper()
+	return t.report3(actual, expected, ulps, msg,
+		isInULP(actual, expected, ulps, t.equalNaN))
+}
+
+func isInULP(actual, expected any, ulps uint64, equalNaN bool) bool {
+	v, e := reflect.ValueOf(actual), reflect.ValueOf(expected)
+	var bits int
+	switch v.Kind() { //nolint:exhaustive // Covered by default case.
+	case reflect.Float32, reflect.Complex64:
+		bits = 32
+	case reflect.Float64, reflect.Complex128:
+		bits = 64
+	default:
+		panic("actual is not a float or complex")
+	}
+	switch e.Kind() { //nolint:exhaustive // Covered by default case.
+	case reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+	default:
+		panic("expected is not a float or complex")
+	}
+	a, ex := complexOf(actual, "actual"), complexOf(expected, "expected")
+	if bits == 32 {
+		a = complex(float64(float32(real(a))), float64(float32(imag(a))))
+		ex = complex(float64(float32(real(ex))), float64(float32(imag(ex))))
+	}
+	return isTolerated(a, ex, equalNaN, func() bool {
+		return ulpDistance(real(a), real(ex), bits) <= ulps &&
+			ulpDistance(imag(a), imag(ex), bits) <= ulps
+	})
+}
+
+// ulpDistance returns amount of representable floats of given bit size
+// between a and b plus 1 (or 0 if they're equal).
+// Both a and b must be finite.
+func ulpDistance(a, b float64, bits int) uint64 {
+	ia, ib := orderedBits(a, bits), orderedBits(b, bits)
+	if ia >= ib {
+		return uint64(ia) - uint64(ib) //nolint:gosec // Two's complement: exact even when ia-ib overflows int64.
+	}
+	return uint64(ib) - uint64(ia) //nolint:gosec // Two's complement: exact even when ib-ia overflows int64.
+}
+
+// orderedBits maps f to int64 preserving order, with adjacent floats
+// of given bit size mapped to adjacent integers (and -0 mapped like +0).
+func orderedBits(f float64, bits int) int64 {
+	var i, minimum int64
+	if bits == 32 {
+		i, minimum = int64(int32(math.Float32bits(float32(f)))), math.MinInt32 //nolint:gosec // Sign bit becomes sign.
+	} else {
+		i, minimum = int64(math.Float64bits(f)), math.MinInt64 //nolint:gosec // Sign bit becomes sign.
+	}
+	if i < 0 {
+		i = minimum - i
+	}
+	return i
+}
+
+// NotInULP checks that actual and expected differs in more than ulps
+// units in the last place.
+//
+// See InULP about supported actual/expected types and check logic.
+func (t *checks) NotInULP(actual, expected any, ulps uint64, msg ...any) bool {
+	t.tb.Helper()
+	return t.report3(actual, expected, ulps, msg,
+		!isInULP(actual, expected, ulps, t.equalNaN))
+}
+
+// isTolerated applies rules about NaN and Inf common for all tolerance
+// checkers and calls within only if both a and b are finite and not equal.
+func isTolerated(a, b complex128, equalNaN bool, within func() bool) bool {
+	switch {
+	case cmplx.IsNaN(a) || cmplx.IsNaN(b):
+		return equalNaN && cmplx.IsNaN(a) && cmplx.IsNaN(b)
+	case a == b:
+		return true
+	case cmplx.IsInf(a) || cmplx.IsInf(b):
+		return false
+	}
+	return within()
+}
+
+// complexOf returns number v as complex128 or panics
+// (using name of v in panic message).
+func complexOf(v any, name string) complex128 {
+	switch val := reflect.ValueOf(v); val.Kind() { //nolint:exhaustive // Covered by default case.
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return complex(float64(val.Int()), 0)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return complex(float64(val.Uint()), 0)
+	case reflect.Float32, reflect.Float64:
+		return complex(val.Float(), 0)
+	case reflect.Complex64, reflect.Complex128:
+		return val.Complex()
+	}
+	panic(name + " is not a number")
+}
+
+// InDeltaEach checks InDelta for each pair of elements of actual and
+// expected with same index (for slices and arrays) or key (for maps).
+//
+// Both actual and expected must be slices or arrays with same length or
+// maps with same set of keys (this is checked first), with elements of
+// types supported by InDelta (numbers or [time.Time]).
+//
+// On failure it shows only elements not within delta, with their
+// indices or keys, actual and expected values and deviation |actual-expected|.
+func (t *checks) InDeltaEach(actual, expected, delta any, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportEachTolerated(actual, expected, delta, msg,
+		func(a, e any) bool { return isInDelta(a, e, delta, t.equalNaN) },
+		deltaOf)
+}
+
+// InSMAPEEach checks InSMAPE for each pair of elements of actual and
+// expected with same index (for slices and arrays) or key (for maps).
+//
+// See InDeltaEach about supported actual/expected types and check logic.
+// Deviation is SMAPE of elements.
+func (t *checks) InSMAPEEach(actual, expected any, smape float64, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportEachTolerated(actual, expected, smape, msg,
+		func(a, e any) bool { return isInSMAPE(a, e, smape, t.equalNaN) },
+		func(a, e any) any {
+			ca, ce := complexOf(a, "actual"), complexOf(e, "expected")
+			return 100 * cmplx.Abs(ce-ca) / (cmplx.Abs(ce) + cmplx.Abs(ca))
+		})
+}
+
+// InEpsilonEach checks InEpsilon for each pair of elements of actual and
+// expected with same index (for slices and arrays) or key (for maps).
+//
+// See InDeltaEach about supported actual/expected types and check logic.
+// Deviation is relative error of elements.
+func (t *checks) InEpsilonEach(actual, expected any, epsilon float64, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportEachTolerated(actual, expected, epsilon, msg,
+		func(a, e any) bool { return isInEpsilon(a, e, epsilon, t.equalNaN) },
+		func(a, e any) any {
+			ca, ce := complexOf(a, "actual"), complexOf(e, "expected")
+			return cmplx.Abs(ca-ce) / cmplx.Abs(ce)
+		})
+}
+
+// InULPEach checks InULP for each pair of elements of actual and
+// expected with same index (for slices and arrays) or key (for maps).
+//
+// See InDeltaEach about supported actual/expected types and check logic.
+// Deviation is a distance in ULPs (for complex numbers - max of
+// distances between real and imaginary parts).
+func (t *checks) InULPEach(actual, expected any, ulps uint64, msg ...any) bool {
+	t.tb.Helper()
+	return t.reportEachTolerated(actual, expected, ulps, msg,
+		func(a, e any) bool { return isInULP(a, e, ulps, t.equalNaN) },
+		func(a, e any) any {
+			bits := 64
+			if k := reflect.ValueOf(a).Kind(); k == reflect.Float32 || k == reflect.Complex64 {
+				bits = 32
+			}
+			ca, ce := complexOf(a, "actual"), complexOf(e, "expected")
+			if cmplx.IsNaN(ca) || cmplx.IsNaN(ce) || cmplx.IsInf(ca) || cmplx.IsInf(ce) {
+				return note("n/a")
+			}
+			return max(ulpDistance(real(ca), real(ce), bits), ulpDistance(imag(ca), imag(ce), bits))
+		})
+}
+
+// deltaOf returns |actual-expected| for numbers or [time.Time].
+func deltaOf(actual, expected any) any {
+	if a, ok := actual.(time.Time); ok {
+		d := a.Sub(expected.(time.Time)) //nolint:forcetypeassert // Want panic.
+		return max(d, -d)
+	}
+	switch v, e := reflect.ValueOf(actual), reflect.ValueOf(expected); v.Kind() { //nolint:exhaustive // Others are floats.
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		a, b := v.Int(), e.Int()
+		if a >= b {
+			return uint64(a) - uint64(b) //nolint:gosec // Two's complement: exact even when a-b overflows int64.
+		}
+		return uint64(b) - uint64(a) //nolint:gosec // Two's complement: exact even when b-a overflows int64.
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		a, b := v.Uint(), e.Uint()
+		return max(a, b) - min(a, b)
+	}
+	return cmplx.Abs(complexOf(actual, "actual") - complexOf(expected, "expected"))
+}
+
+// elemPair is a pair of elements of actual and expected with same index or key.
+type elemPair struct {
+	name     string // Index or key in square brackets.
+	actual   any
+	expected any
+}
+
+// elemPairs returns pairs of elements of actual and expected with same
+// index (for slices and arrays) or key (for maps, sorted by key).
+// If actual and expected have different length or set of keys
+// it returns only description of the difference.
+func elemPairs(actual, expected any) (pairs []elemPair, mismatch string) {
+	va, ve := reflect.ValueOf(actual), reflect.ValueOf(expected)
+	switch va.Kind() { //nolint:exhaustive // Covered by default case.
+	case reflect.Slice, reflect.Array:
+		if ve.Kind() != reflect.Slice && ve.Kind() != reflect.Array {
+			panic("expected is not a slice or array")
+		}
+		if va.Len() != ve.Len() {
+			return nil, fmt.Sprintf("expected %d elements, got %d", ve.Len(), va.Len())
+		}
+		for i := range va.Len() {
+			pairs = append(pairs, elemPair{fmt.Sprintf("[%d]", i), va.Index(i).Interface(), ve.Index(i).Interface()})
+		}
+	case reflect.Map:
+		if ve.Kind() != reflect.Map {
+			panic("expected is not a map")
+		}
+		var missing, unexpected []string
+		for _, k := range sortedMapKeys(ve) {
+			if !va.MapIndex(k).IsValid() {
+				missing = append(missing, matcherArg(k.Interface()))
+			}
+		}
+		for _, k := range sortedMapKeys(va) {
+			name := "[" + matcherArg(k.Interface()) + "]"
+			if e := ve.MapIndex(k); !e.IsValid() {
+				unexpected = append(unexpected, matcherArg(k.Interface()))
+			} else {
+				pairs = append(pairs, elemPair{name, va.MapIndex(k).Interface(), e.Interface()})
+			}
+		}
+		var diff []string
+		if len(missing) > 0 {
+			diff = append(diff, "missing keys: "+strings.Join(missing, ", "))
+		}
+		if len(unexpected) > 0 {
+			diff = append(diff, "unexpected keys: "+strings.Join(unexpected, ", "))
+		}
+		if len(diff) > 0 {
+			return nil, strings.Join(diff, "; ")
+		}
+	default:
+		panic("actual is not a slice, array or map")
+	}
+	return pairs, ""
+}
+
+// reportEachTolerated reports summary as actual and explains it with
+// elements not within tolerance (instead of showing whole collections).
+func (c *checks) reportEachTolerated(actual, expected, tolerance any, msg []any,
+	within func(actual, expected any) bool, deviation func(actual, expected any) any,
+) bool {
+	c.tb.Helper()
+	checker := callerFuncName(1)
+	toleranceName := "Delta"
+	switch {
+	case strings.Contains(checker, "SMAPE"):
+		toleranceName = "SMAPE"
+	case strings.Contains(checker, "Epsilon"):
+		toleranceName = "Epsilon"
+	case strings.Contains(checker, "ULP"):
+		toleranceName = "ULPs"
+	}
+
+	pairs, mismatch := elemPairs(actual, expected)
+	var failed []elemPair
+	for _, pair := range pairs {
+		if !within(pair.actual, pair.expected) {
+			failed = append(failed, pair)
+		}
+	}
+	ok := mismatch == "" && len(failed) == 0
+	summary := mismatch
+	if summary == "" {
+		summary = fmt.Sprintf("%d of %d elements are not within %s", len(failed), len(pairs), strings.ToLower(toleranceName))
+	}
+	return c.reportExplained(ok, msg,
+		checker,
+		[]string{nameActual, toleranceName},
+		[]any{note(summary), tolerance},
+		func() string {
+			if len(failed) == 0 {
+				return ""
+			}
+			name := make([]string, len(failed))
+			values := make([]any, len(failed))
+			for i, pair := range failed {
+				name[i] = pair.name
+				values[i] = note(fmt.Sprintf("actual %s, expected %s, deviation %v",
+					matcherArg(pair.actual), matcherArg(pair.expected), deviation(pair.actual, pair.expected)))
+			}
+			return explain("Not within "+strings.ToLower(toleranceName), name, values)
+		})
+}
@@ -0,0 +1,192 @@
+package check_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/powerman/check"
+)
+
+func TestInEpsilon(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.InEpsilon(101, 100, 0.01)
+	t.InEpsilon(99, 100, 0.01)
+	t.InEpsilon(uint8(99), uint16(100), 0.01)
+	t.InEpsilon(-1.01e-9, -1e-9, 0.011)
+	t.InEpsilon(1e300, 1.001e300, 0.001)
+	t.InEpsilon(0, 0, 0)
+	t.InEpsilon(0.0, 0, 0.1)
+	t.InEpsilon(complex(3, 4.01), complex(3, 4), 0.01)
+	t.InEpsilon(complex64(1i), 1i, 0)
+	todo.InEpsilon(102, 100, 0.01)
+	todo.InEpsilon(0.001, 0, 0.1)
+	todo.InEpsilon(complex(3, 4.1), complex(3, 4), 0.01)
+	t.NotInEpsilon(102, 100, 0.01)
+	t.NotInEpsilon(0.001, 0.0, 0.1)
+	todo.NotInEpsilon(99, 100, 0.01)
+
+	t.PanicMatch(func() { t.InEpsilon(1, 1, -0.1) }, "epsilon is not >= 0")
+	t.PanicMatch(func() { t.InEpsilon(1, 1, math.NaN()) }, "epsilon is not >= 0")
+	t.PanicMatch(func() { t.InEpsilon("1", 1, 0.1) }, "actual is not a number")
+	t.PanicMatch(func() { t.InEpsilon(1, nil, 0.1) }, "expected is not a number")
+}
+
+func TestInULP(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.InULP(1.0, 1.0, 0)
+	t.InULP(1.0, math.Nextafter(1, 2), 1)
+	t.InULP(math.Nextafter(1, 0), math.Nextafter(1, 2), 2)
+	t.InULP(0.1+0.2, 0.3, 1)
+	t.InULP(0.0, math.Copysign(0, -1), 0)
+	t.InULP(math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, 2)
+	t.InULP(math.MaxFloat64, math.Nextafter(math.MaxFloat64, 0), 1)
+	t.InULP(float32(1), math.Nextafter32(1, 2), 1)
+	t.InULP(float32(0.1), 0.1, 0)
+	t.InULP(complex(1, 1), complex(1, math.Nextafter(1, 2)), 1)
+	t.InULP(complex64(complex(1, 1)), complex(math.Nextafter32(1, 0), 1), 1)
+	todo.InULP(1.0, math.Nextafter(1, 2), 0)
+	todo.InULP(math.Nextafter(1, 0), math.Nextafter(1, 2), 1)
+	todo.InULP(float32(1), math.Nextafter32(1, 2), 0)
+	todo.InULP(1.0, float64(math.Nextafter32(1, 2)), 1000)
+	todo.InULP(-1.0, 1.0, 2*math.Float64bits(1)-1)
+	t.InULP(-1.0, 1.0, 2*math.Float64bits(1))
+	t.InULP(-math.MaxFloat64, math.MaxFloat64, math.MaxUint64)
+	t.NotInULP(1.0, math.Nextafter(1, 2), 0)
+	t.NotInULP(complex(1, 1), complex(1, 1.1), 1)
+	todo.NotInULP(1.0, 1.0, 0)
+
+	t.PanicMatch(func() { t.InULP(1, 1.0, 1) }, "actual is not a float or complex")
+	t.PanicMatch(func() { t.InULP(1.0, 1, 1) }, "expected is not a float or complex")
+}
+
+func TestToleranceNaNInf(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	nan, inf := math.NaN(), math.Inf(1)
+	cnan := complex(1, nan)
+	cinf := complex(inf, 1)
+
+	t.InDelta(inf, inf, 0.1)
+	t.InDelta(-inf, -inf, 0.0)
+	t.InDelta(cinf, cinf, 0.1)
+	t.InEpsilon(inf, inf, 0.1)
+	t.InULP(inf, inf, 0)
+	t.InULP(float32(-inf), -inf, 0)
+	t.InSMAPE(inf, inf, 1)
+	todo.InDelta(inf, -inf, math.MaxFloat64)
+	todo.InDelta(math.MaxFloat64, inf, math.MaxFloat64)
+	todo.InEpsilon(math.MaxFloat64, inf, 1)
+	todo.InULP(math.MaxFloat64, inf, 1)
+	todo.InSMAPE(math.MaxFloat64, inf, 99)
+
+	todo.InDelta(nan, nan, 1.0)
+	todo.InDelta(nan, 1.0, 1.0)
+	todo.InDelta(cnan, cnan, 1.0)
+	todo.InEpsilon(nan, nan, 1)
+	todo.InULP(nan, nan, 1)
+	todo.InSMAPE(nan, nan, 1)
+	t.NotInDelta(nan, nan, 1.0)
+	t.NotInEpsilon(nan, 1.0, 1)
+
+	nanEqual := t.EqualNaN()
+	nanEqual.InDelta(nan, nan, 1.0)
+	nanEqual.InDelta(cnan, cnan, 1.0)
+	nanEqual.InEpsilon(nan, nan, 0)
+	nanEqual.InULP(float32(nan), nan, 0)
+	nanEqual.InSMAPE(nan, nan, 1)
+	nanEqual.NotInDelta(nan, 1.0, 1.0)
+	nanEqual.TODO().NotInDelta(nan, nan, 1.0)
+	nanEqual.TODO().InDelta(nan, 1.0, 1.0)
+
+	c := check.T(tt).EqualNaN()
+	c.InDelta(nan, nan, 0.0)
+
+	todo.InDelta(1.0, 1.0, nan)
+	todo.InDelta(1i, 1i, nan)
+	todo.InDelta(1.0, 1.0, -1.0)
+	t.NotInDelta(1.0, 1.0, nan)
+	t.NotInDelta(1i, 1i, nan)
+	nanEqual.TODO().InDelta(nan, nan, nan)
+}
+
+func TestToleranceComplex(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	t.InDelta(complex(3, 4), 0i, 5.0)
+	t.InDelta(complex64(complex(1, 1)), complex64(complex(1.1, 1)), float32(0.11))
+	todo.InDelta(complex(3, 4), 0i, 4.99)
+	todo.InDelta(1i, 1i, -1.0)
+	t.NotInDelta(complex(3, 4), 0i, 4.99)
+	t.PanicMatch(func() { t.InDelta(1i, 1i, 1) }, `reflect: call of reflect.Value.Float on int Value`)
+
+	t.InSMAPE(complex(0, 100), complex(0, 101), 1)
+	t.InSMAPE(complex64(complex(0, 100)), complex(0, 100.5), 1)
+	todo.InSMAPE(1i, 0i, 99)
+	t.NotInSMAPE(complex(0, 100), complex(0, 110), 1)
+}
+
+func TestToleranceEach(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+	now := time.Now()
+
+	t.InDeltaEach([]float64{1, 2, 3}, []float64{1.1, 1.9, 3}, 0.11)
+	t.InDeltaEach([3]int{1, 2, 3}, []int{2, 1, 3}, 1)
+	t.InDeltaEach([]any{1.0, 2.0, 3i}, []any{1.0, 2.5, 3.1i}, 0.5)
+	t.InDeltaEach(map[string]float64{"a": 1, "b": 2}, map[string]float64{"b": 2.1, "a": 1}, 0.11)
+	t.InDeltaEach([]time.Time{now}, []time.Time{now.Add(time.Second)}, time.Second)
+	t.InDeltaEach([]float64(nil), []float64{}, 0.1)
+	todo.InDeltaEach([]float64{1, 2, 3}, []float64{1.1, 1.9, 3}, 0.05)
+	todo.InDeltaEach([]float64{1, 2}, []float64{1, 2, 3}, 0.1)
+	todo.InDeltaEach(map[string]float64{"a": 1}, map[string]float64{"b": 1}, 0.1)
+
+	t.InSMAPEEach([]float64{100, 200}, []float64{101, 199}, 1)
+	todo.InSMAPEEach([]float64{100, 200}, []float64{110, 199}, 1)
+	t.InEpsilonEach([]int{100, 198}, []int{101, 200}, 0.01)
+	todo.InEpsilonEach([]int{100, 0}, []int{101, 1}, 0.01)
+	t.InULPEach([]float64{1, 0.1 + 0.2}, []float64{1, 0.3}, 1)
+	todo.InULPEach([]float32{1, 2}, []float32{math.Nextafter32(1, 2), 2}, 0)
+
+	nan := math.NaN()
+	todo.InDeltaEach([]float64{nan}, []float64{nan}, 0.1)
+	t.EqualNaN().InDeltaEach([]float64{nan}, []float64{nan}, 0.1)
+
+	t.PanicMatch(func() { t.InDeltaEach(1.0, []float64{1}, 0.1) }, "actual is not a slice, array or map")
+	t.PanicMatch(func() { t.InDeltaEach([]float64{1}, 1.0, 0.1) }, "expected is not a slice or array")
+	t.PanicMatch(func() { t.InDeltaEach(map[int]float64{}, []float64{}, 0.1) }, "expected is not a map")
+	t.PanicMatch(func() { t.InDeltaEach([]string{"1"}, []string{"1"}, 0.1) }, "actual is not a number or time.Time")
+}
+
+func TestToleranceEachReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeToleranceEachReport"}
+	t := check.New(fake)
+
+	t.InDeltaEach([]float64{1, 2, 3, 4}, []float64{1, 2.5, 3, 3}, 0.1)
+	t.InDeltaEach([]float64{1, 2}, []float64{1, 2, 3}, 0.1)
+	t.InEpsilonEach(map[string]int{"a": 1, "c": 3}, map[string]int{"a": 1, "b": 2}, 0.1)
+	t.InULPEach([]float64{1}, []float64{math.Nextafter(math.Nextafter(1, 2), 2)}, 1)
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 4)
+	realT.Contains(fake.msgs[0], "Delta:    (float64) 0.1\n")
+	realT.Contains(fake.msgs[0], "Actual:   2 of 4 elements are not within delta\n")
+	realT.Contains(fake.msgs[0], "Not within delta:\n"+
+		"  [1]: actual 2, expected 2.5, deviation 0.5\n"+
+		"  [3]: actual 4, expected 3, deviation 1\n")
+	realT.Contains(fake.msgs[1], "Actual:   expected 3 elements, got 2\n")
+	realT.NotContains(fake.msgs[1], "Not within")
+	realT.Contains(fake.msgs[2], `Actual:   missing keys: "b"; unexpected keys: "c"`)
+	realT.Contains(fake.msgs[3], "[0]: actual 1, expected 1.0000000000000004, deviation 2\n")
+}
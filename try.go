@@ -0,0 +1,229 @@
+package check
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Failure describes a failed check (or a skip) recorded by [TB.Try].
+type Failure struct {
+	// Message is a failure report (same as shown for a failed check in
+	// a real test, without colors). It's empty if a test was marked
+	// as failed without a message (e.g. by Fail or FailNow).
+	Message string
+	// Skipped is true if it's not a failure but a skip (e.g. by Skip):
+	// Message is a skip message in this case.
+	Skipped bool
+}
+
+// String returns f.Message.
+func (f Failure) String() string { return f.Message }
+
+// tryTB is a [testing.TB] used by Try to record failures instead of
+// reporting them to a real test.
+// Everything not related to failures (TempDir, Cleanup, Context, etc.)
+// is delegated to a real test.
+type tryTB struct {
+	testing.TB
+
+	mu       sync.Mutex
+	failures []Failure
+	failed   bool
+	skipped  bool
+}
+
+func (*tryTB) Helper()                          {}
+func (*tryTB) Log(...any)                       {}
+func (*tryTB) Logf(string, ...any)              {}
+func (r *tryTB) Error(args ...any)              { r.record(fmt.Sprintln(args...)) }
+func (r *tryTB) Errorf(format string, a ...any) { r.record(fmt.Sprintf(format, a...)) }
+func (r *tryTB) Fatal(args ...any)              { r.Error(args...); runtime.Goexit() }
+func (r *tryTB) Fatalf(format string, a ...any) { r.Errorf(format, a...); runtime.Goexit() }
+func (r *tryTB) FailNow()                       { r.Fail(); runtime.Goexit() }
+func (r *tryTB) Skip(args ...any)               { r.skip(fmt.Sprintln(args...)) }
+func (r *tryTB) Skipf(format string, a ...any)  { r.skip(fmt.Sprintf(format, a...)) }
+func (r *tryTB) SkipNow()                       { r.skip("") }
+
+func (r *tryTB) skip(msg string) {
+	r.mu.Lock()
+	r.skipped = true
+	r.failures = append(r.failures, Failure{Message: strings.Trim(msg, "\n"), Skipped: true})
+	r.mu.Unlock()
+	runtime.Goexit()
+}
+
+func (r *tryTB) Skipped() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.skipped
+}
+
+func (r *tryTB) Fail() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failed = true
+}
+
+func (r *tryTB) Failed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failed
+}
+
+func (r *tryTB) record(msg string) {
+	for _, ansi := range []string{ansiGreen, ansiYellow, ansiRed, ansiReset} {
+		if ansi != "" {
+			msg = strings.ReplaceAll(msg, ansi, "")
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures = append(r.failures, Failure{Message: strings.Trim(msg, "\n")})
+	r.failed = true
+}
+
+// result returns recorded failures.
+func (r *tryTB) result() []Failure {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failed && !slices.ContainsFunc(r.failures, isFailure) {
+		return append([]Failure{{}}, r.failures...)
+	}
+	return r.failures
+}
+
+func isFailure(f Failure) bool { return !f.Skipped }
+
+// Try calls f with a *TB (with same options as t) which records failed
+// checks instead of reporting them, and returns recorded failures
+// (nil if all checks in f have passed).
+// If f is skipped (e.g. by Skip) then last returned Failure has Skipped set.
+// It doesn't affect t or statistics.
+//
+// Like in a real test f is called in a new goroutine and stops on
+// FailNow or SkipNow (and so on failed check of [Must], Fatal, Skip, etc.).
+// A panic in f is re-raised in the caller's goroutine.
+// Everything not related to failures (Cleanup, TempDir, Context, etc.)
+// works with t, and logged messages are discarded.
+func (t *checks) Try(f func(t *TB)) []Failure {
+	t.tb.Helper()
+	r := &tryTB{TB: t.tb}
+	d := *t
+	d.tb = r
+	d.noStats = true
+	var panicVal any
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		returned := false
+		defer func() {
+			if !returned {
+				panicVal = recover()
+			}
+		}()
+		f(&TB{TB: r, checks: &d})
+		returned = true
+	}()
+	<-done
+	if panicVal != nil {
+		panic(panicVal)
+	}
+	return r.result()
+}
+
+// AnyOf checks that at least one of blocks passes: each block is called
+// (like in [TB.Try]) until one of them passes.
+// Skipped block doesn't pass.
+// Checks in blocks aren't affected by TODO used for AnyOf itself.
+//
+// On failure it shows failures of every block.
+func (t *checks) AnyOf(blocks ...func(t *TB)) bool {
+	t.tb.Helper()
+	results := make([][]Failure, 0, len(blocks))
+	for _, block := range blocks {
+		failures := t.tryBlock(block)
+		results = append(results, failures)
+		if blockPassed(failures) {
+			break
+		}
+	}
+	return t.reportBlocks(results, len(blocks), false)
+}
+
+// OneOf checks that exactly one of blocks passes: each block is called
+// (like in [TB.Try]).
+// Skipped block doesn't pass.
+// Checks in blocks aren't affected by TODO used for OneOf itself.
+//
+// On failure it shows failures of every block.
+func (t *checks) OneOf(blocks ...func(t *TB)) bool {
+	t.tb.Helper()
+	results := make([][]Failure, 0, len(blocks))
+	for _, block := range blocks {
+		results = append(results, t.tryBlock(block))
+	}
+	return t.reportBlocks(results, len(blocks), true)
+}
+
+// tryBlock calls Try for block without TODO.
+func (t *checks) tryBlock(block func(t *TB)) []Failure {
+	t.tb.Helper()
+	d := *t
+	d.todo = false
+	return d.Try(block)
+}
+
+// blockPassed returns true if block with given failures (returned by Try) passed.
+func blockPassed(failures []Failure) bool { return len(failures) == 0 }
+
+// reportBlocks reports results of AnyOf or OneOf (with exactlyOne=true).
+// Results may be shorter than total if not all blocks were called.
+func (t *checks) reportBlocks(results [][]Failure, total int, exactlyOne bool) bool {
+	t.tb.Helper()
+	passed := 0
+	for _, failures := range results {
+		if blockPassed(failures) {
+			passed++
+		}
+	}
+	ok := passed == 1 || (!exactlyOne && passed > 1)
+	return t.reportExplained(ok, nil,
+		callerFuncName(1),
+		[]string{"Passed"},
+		[]any{note(fmt.Sprintf("%d of %d blocks", passed, total))},
+		func() string { return explainBlocks(results) })
+}
+
+// explainBlocks returns a list of results of blocks.
+func explainBlocks(results [][]Failure) string {
+	var buf strings.Builder
+	buf.WriteString("Blocks:\n")
+	for i, failures := range results {
+		switch {
+		case blockPassed(failures):
+			fmt.Fprintf(&buf, "  [%d]: passed\n", i)
+			continue
+		case slices.ContainsFunc(failures, isFailure):
+			fmt.Fprintf(&buf, "  [%d]: failed\n", i)
+		default:
+			fmt.Fprintf(&buf, "  [%d]: skipped\n", i)
+		}
+		for _, f := range failures {
+			msg := f.Message
+			switch {
+			case f.Skipped && msg == "":
+				continue
+			case f.Skipped:
+				msg = "skipped: " + msg
+			case msg == "":
+				msg = "(no message)"
+			}
+			fmt.Fprintf(&buf, "    %s\n", strings.ReplaceAll(msg, "\n", "\n    "))
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,134 @@
+package check_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+func TestTry(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+
+	t.Nil(t.Try(func(t *check.TB) {
+		t.Equal(1, 1)
+		t.True(true)
+	}))
+
+	reached := false
+	failures := t.Try(func(t *check.TB) {
+		t.Equal(1, 2)
+		t.True(false, "message")
+		t.MustAll().Nil(errBoom)
+		reached = true
+	})
+	t.False(reached)
+	t.Len(failures, 3)
+	t.Equal(failures[0].String(), "Checker:  Equal\nExpected: (int) 2\nActual:   (int) 1")
+	t.Equal(failures[1].Message, "message\nChecker:  True")
+	t.HasPrefix(failures[2].Message, "Checker:  Nil\n")
+
+	t.DeepEqual(t.Try(func(t *check.TB) { t.Fail() }), []check.Failure{{}})
+	t.DeepEqual(t.Try(func(t *check.TB) { t.Errorf("a %d", 1); t.Fatal("b") }),
+		[]check.Failure{{Message: "a 1\nChecker:  Errorf"}, {Message: "b\nChecker:  Fatal"}})
+	t.DeepEqual(t.Try(func(t *check.TB) { t.Log("log"); t.SkipNow(); t.Fail() }),
+		[]check.Failure{{Skipped: true}})
+	t.DeepEqual(t.Try(func(t *check.TB) { t.Skip("not now") }),
+		[]check.Failure{{Message: "not now", Skipped: true}})
+	t.DeepEqual(t.Try(func(t *check.TB) { t.Fail(); t.Skipf("after %s", "fail") }),
+		[]check.Failure{{}, {Message: "after fail", Skipped: true}})
+
+	// Options are inherited.
+	t.Nil(t.EqualNaN().Try(func(t *check.TB) { t.InDelta(math.NaN(), math.NaN(), 0.1) }))
+	t.Len(t.TODO().Try(func(t *check.TB) { t.True(true) }), 1)
+
+	t.PanicValue(func() { t.Try(func(*check.TB) { panic("oops") }) }, "oops")
+}
+
+func TestTryStats(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeTryStats"}
+	t := check.New(fake)
+	t.Len(t.Try(func(t *check.TB) { t.True(false); t.FailNow() }), 1)
+	realT := check.T(tt)
+	realT.Zero(fake.errorfCalls)
+	realT.False(fake.failNowCalled)
+}
+
+func TestAnyOfOneOf(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	pass := func(t *check.TB) { t.True(true) }
+	fail := func(t *check.TB) { t.True(false) }
+	called := false
+	mark := func(*check.TB) { called = true }
+
+	t.AnyOf(pass)
+	t.AnyOf(fail, pass)
+	t.AnyOf(pass, pass)
+	t.AnyOf(pass, mark)
+	t.False(called)
+	todo.AnyOf()
+	todo.AnyOf(fail)
+	todo.AnyOf(fail, fail)
+
+	t.OneOf(pass)
+	t.OneOf(fail, pass, fail)
+	todo.OneOf()
+	todo.OneOf(fail, fail)
+	todo.OneOf(pass, fail, pass)
+	todo.OneOf(pass, mark)
+
+	skip := func(t *check.TB) { t.Skip() }
+	todo.AnyOf(skip)
+	t.AnyOf(skip, pass)
+	todo.OneOf(skip)
+	t.OneOf(skip, pass)
+	t.True(called)
+}
+
+func TestAnyOfOneOfReport(tt *testing.T) {
+	tt.Parallel()
+	fake := &fakeTB{name: "fakeAnyOfOneOfReport"}
+	t := check.New(fake)
+
+	t.AnyOf(
+		func(t *check.TB) { t.Equal(1, 2) },
+		func(t *check.TB) { t.True(false, "second"); t.Fail() },
+	)
+	t.OneOf(
+		func(t *check.TB) { t.True(true) },
+		func(t *check.TB) { t.Fail() },
+		func(t *check.TB) { t.True(true) },
+	)
+
+	t.AnyOf(
+		func(t *check.TB) { t.Skip("no network") },
+		func(t *check.TB) { t.SkipNow() },
+	)
+
+	realT := check.T(tt)
+	realT.Equal(len(fake.msgs), 3)
+	realT.Contains(fake.msgs[2], "Passed:   0 of 2 blocks\n\nBlocks:\n"+
+		"  [0]: skipped\n"+
+		"    skipped: no network\n"+
+		"  [1]: skipped\n")
+	realT.Contains(fake.msgs[0], "Checker:  AnyOf\nPassed:   0 of 2 blocks\n\nBlocks:\n"+
+		"  [0]: failed\n"+
+		"    Checker:  Equal\n"+
+		"    Expected: (int) 2\n"+
+		"    Actual:   (int) 1\n")
+	realT.Contains(fake.msgs[0], "  [1]: failed\n"+
+		"    second\n"+
+		"    Checker:  True\n")
+	realT.Contains(fake.msgs[1], "Checker:  OneOf\nPassed:   2 of 3 blocks\n\nBlocks:\n"+
+		"  [0]: passed\n"+
+		"  [1]: failed\n"+
+		"    (no message)\n"+
+		"  [2]: passed\n")
+	realT.False(strings.Contains(fake.msgs[1], "\n\n\n\n"))
+}
@@ -0,0 +1,169 @@
+package check
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// XMLEqual normalize actual and expected (if they're valid XML)
+// and then checks for equality of their canonical forms:
+// attribute order, namespace prefixes (names are compared by namespace URI),
+// XML declaration, comments and DOCTYPE are ignored.
+// Use [TB.IgnoreXMLSpace] to also ignore whitespace-only text between elements.
+//
+// Both actual and expected may have any of these types:
+//   - string
+//   - []byte
+//   - [io.Reader] (it'll be read until EOF)
+//   - nil
+//
+// In case any of actual or expected is nil or empty or is invalid XML - check will fail.
+// On failure it shows canonical forms of actual and expected (if they're valid XML)
+// pretty-printed, with a diff.
+func (t *checks) XMLEqual(actual, expected any, msg ...any) bool {
+	t.tb.Helper()
+	xmlActual, okActual := xmlify(actual, t.ignoreXMLSpace)
+	xmlExpected, okExpected := xmlify(expected, t.ignoreXMLSpace)
+	ok := okActual && okExpected && xmlActual == xmlExpected
+	if !ok {
+		if okActual {
+			actual = xmlActual
+		}
+		if okExpected {
+			expected = xmlExpected
+		}
+	}
+	return t.report2(actual, expected, msg,
+		ok)
+}
+
+// xmlify returns canonical pretty-printed form of arg and true if arg is a non-empty valid XML.
+func xmlify(arg any, ignoreSpace bool) (string, bool) {
+	var r io.Reader
+	switch v := arg.(type) {
+	case nil:
+		return "", false
+	case io.Reader:
+		r = v
+	default:
+		r = bytes.NewReader(reflect.ValueOf(arg).Convert(typBytes).Interface().([]byte)) //nolint:forcetypeassert // Want panic.
+	}
+
+	root, err := parseXML(r, ignoreSpace)
+	if err != nil || len(root.children) == 0 {
+		return "", false
+	}
+	var buf strings.Builder
+	for _, node := range root.children {
+		node.write(&buf, "", "")
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), true
+}
+
+// xmlNode is either element (if name.Local is not empty) or text.
+type xmlNode struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*xmlNode
+	text     string
+}
+
+func parseXML(r io.Reader, ignoreSpace bool) (*xmlNode, error) {
+	dec := xml.NewDecoder(r)
+	root := &xmlNode{}
+	stack := []*xmlNode{root}
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		cur := stack[len(stack)-1]
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: tok.Name}
+			for _, attr := range tok.Attr {
+				if attr.Name.Space != "xmlns" && !(attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					node.attrs = append(node.attrs, attr)
+				}
+			}
+			slices.SortFunc(node.attrs, func(a, b xml.Attr) int {
+				return strings.Compare(a.Name.Space+" "+a.Name.Local, b.Name.Space+" "+b.Name.Local)
+			})
+			cur.children = append(cur.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) == 1 || (ignoreSpace && len(bytes.TrimSpace(tok)) == 0) {
+				continue
+			}
+			if n := len(cur.children); n > 0 && cur.children[n-1].name.Local == "" {
+				cur.children[n-1].text += string(tok)
+			} else {
+				cur.children = append(cur.children, &xmlNode{text: string(tok)})
+			}
+		}
+	}
+	return root, nil
+}
+
+// write pretty-prints node with given indent.
+// Namespace of element is declared using default namespace when it differs from parentSpace,
+// namespaces of attributes are declared using prefixes a1, a2, ….
+func (node *xmlNode) write(buf *strings.Builder, indent, parentSpace string) {
+	if node.name.Local == "" {
+		buf.WriteString(indent)
+		_ = xml.EscapeText(buf, []byte(node.text))
+		buf.WriteString("\n")
+		return
+	}
+
+	buf.WriteString(indent + "<" + node.name.Local)
+	if node.name.Space != parentSpace {
+		fmt.Fprintf(buf, ` xmlns="%s"`, xmlEscape(node.name.Space))
+	}
+	prefixes := make(map[string]string)
+	for _, attr := range node.attrs {
+		if attr.Name.Space != "" && prefixes[attr.Name.Space] == "" {
+			prefixes[attr.Name.Space] = fmt.Sprintf("a%d", len(prefixes)+1)
+			fmt.Fprintf(buf, ` xmlns:%s="%s"`, prefixes[attr.Name.Space], xmlEscape(attr.Name.Space))
+		}
+	}
+	for _, attr := range node.attrs {
+		buf.WriteString(" ")
+		if attr.Name.Space != "" {
+			buf.WriteString(prefixes[attr.Name.Space] + ":")
+		}
+		fmt.Fprintf(buf, `%s="%s"`, attr.Name.Local, xmlEscape(attr.Value))
+	}
+
+	switch {
+	case len(node.children) == 0:
+		buf.WriteString("/>\n")
+	case len(node.children) == 1 && node.children[0].name.Local == "":
+		buf.WriteString(">")
+		_ = xml.EscapeText(buf, []byte(node.children[0].text))
+		buf.WriteString("</" + node.name.Local + ">\n")
+	default:
+		buf.WriteString(">\n")
+		for _, child := range node.children {
+			child.write(buf, indent+"  ", node.name.Space)
+		}
+		buf.WriteString(indent + "</" + node.name.Local + ">\n")
+	}
+}
+
+// xmlEscape returns s escaped to be used as XML attribute value.
+func xmlEscape(s string) string {
+	var buf strings.Builder
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
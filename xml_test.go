@@ -0,0 +1,58 @@
+package check_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+func TestXMLEqual(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	todo := t.TODO()
+
+	const feed = `<?xml version="1.0" encoding="UTF-8"?>
+<!-- comment -->
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:x="urn:x"><title type="text" x:a="1">Q&amp;A</title><entry/></feed>`
+	const feedPrefixed = `<a:feed xmlns:a="http://www.w3.org/2005/Atom" xmlns:y="urn:x"><a:title y:a="1" type="text"><!-- c -->Q&amp;<![CDATA[A]]></a:title><a:entry></a:entry></a:feed>`
+	const feedIndented = `
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:x="urn:x">
+	<title type="text" x:a="1">Q&amp;A</title>
+	<entry/>
+</feed>
+`
+	for _, actual := range []any{feed, []byte(feed), strings.NewReader(feed)} {
+		t.XMLEqual(actual, feedPrefixed)
+	}
+	t.XMLEqual(feedPrefixed, []byte(feed))
+	t.XMLEqual(strings.NewReader(feedPrefixed), strings.NewReader(feed))
+	todo.XMLEqual(feed, feedIndented)
+	t.IgnoreXMLSpace().XMLEqual(feed, feedIndented)
+	t.XMLEqual(feedIndented, feedIndented)
+	t.XMLEqual(`<a> <b/> </a>`, "<a> <b></b> </a>")
+	todo.XMLEqual(`<a> <b/> </a>`, "<a>\n<b/>\n</a>")
+	t.IgnoreXMLSpace().XMLEqual(`<a> <b/> </a>`, "<a>\n<b/>\n</a>")
+	todo.IgnoreXMLSpace().XMLEqual(`<a> x <b/> </a>`, "<a>x<b/></a>")
+
+	todo.XMLEqual(`<a/>`, `<b/>`)
+	todo.XMLEqual(`<a xmlns="urn:a"/>`, `<a xmlns="urn:b"/>`)
+	todo.XMLEqual(`<a xmlns="urn:a"/>`, `<a/>`)
+	todo.XMLEqual(`<a x="1"/>`, `<a x="2"/>`)
+	todo.XMLEqual(`<a x="1"/>`, `<a/>`)
+	todo.XMLEqual(`<a xmlns:p="urn:p" p:x="1"/>`, `<a x="1"/>`)
+	todo.XMLEqual(`<a>1</a>`, `<a>2</a>`)
+	todo.XMLEqual(`<a><b/><c/></a>`, `<a><c/><b/></a>`)
+	todo.XMLEqual(`<a/><b/>`, `<a/>`)
+	t.XMLEqual(`<a/><b/>`, `<a/> <b/>`)
+	t.XMLEqual(`<a x="&quot;&#10;"/>`, `<a x='"&#xA;'/>`)
+
+	// Invalid documents.
+	todo.XMLEqual(`<a>`, `<a>`)
+	todo.XMLEqual(`<a></b>`, `<a></b>`)
+	todo.XMLEqual(`text`, `text`)
+	todo.XMLEqual(``, ``)
+	todo.XMLEqual(nil, nil)
+	todo.XMLEqual(`<a/>`, nil)
+	t.Panic(func() { t.XMLEqual(42, `<a/>`) })
+}
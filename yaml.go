@@ -0,0 +1,89 @@
+package check
+
+import (
+	"reflect"
+	"sync"
+)
+
+//nolint:gochecknoglobals // Registry of YAML normalizer.
+var (
+	yamlNormalizerMu sync.RWMutex
+	yamlNormalizer   YAMLNormalizer
+)
+
+// YAMLNormalizer converts YAML stream (one or more documents)
+// into a canonical form used by YAMLEqual: same semantic content
+// must result in same canonical form, no matter of key order,
+// quoting style, comments, etc.
+//
+// It must return an error for invalid YAML.
+type YAMLNormalizer func(yaml []byte) (canonical string, err error)
+
+// RegisterYAMLNormalizer sets YAML normalizer used by YAMLEqual.
+//
+// Core check package has no YAML parser to stay dependency-free,
+// so it must be registered by a companion module:
+//
+//	import _ "github.com/powerman/check/checkyaml"
+//
+// Intended to be called from init() or TestMain.
+// Not safe to call concurrently with running checks.
+func RegisterYAMLNormalizer(f YAMLNormalizer) {
+	yamlNormalizerMu.Lock()
+	defer yamlNormalizerMu.Unlock()
+	yamlNormalizer = f
+}
+
+// YAMLEqual normalize actual and expected (if they're valid YAML)
+// and then checks for equality of their canonical forms.
+// Key order, quoting style and comments are ignored,
+// stream with several documents is compared document by document.
+//
+// Both actual and expected may have any of these types:
+//   - string
+//   - []byte
+//   - nil
+//
+// In case any of actual or expected is nil or empty or is invalid YAML - check will fail.
+// On failure it shows canonical forms of actual and expected (if they're valid YAML).
+//
+// It panics unless companion module is imported to enable YAML support:
+//
+//	import _ "github.com/powerman/check/checkyaml"
+func (t *checks) YAMLEqual(actual, expected any, msg ...any) bool {
+	t.tb.Helper()
+	yamlActual, okActual := yamlify(actual)
+	yamlExpected, okExpected := yamlify(expected)
+	ok := okActual && okExpected && yamlActual == yamlExpected
+	if !ok {
+		if okActual {
+			actual = yamlActual
+		}
+		if okExpected {
+			expected = yamlExpected
+		}
+	}
+	return t.report2(actual, expected, msg,
+		ok)
+}
+
+// yamlify returns canonical form of arg and true if arg is a non-empty valid YAML.
+func yamlify(arg any) (string, bool) {
+	yamlNormalizerMu.RLock()
+	normalize := yamlNormalizer
+	yamlNormalizerMu.RUnlock()
+	if normalize == nil {
+		panic("check: YAML support is not enabled; " +
+			"import github.com/powerman/check/checkyaml to compare YAML")
+	}
+
+	if arg == nil {
+		return "", false
+	}
+	buf := reflect.ValueOf(arg).Convert(typBytes).Interface().([]byte) //nolint:forcetypeassert // Want panic.
+	canonical, err := normalize(buf)
+	if err != nil || canonical == "" {
+		return "", false
+	}
+	return canonical, true
+}
@@ -0,0 +1,13 @@
+package check_test
+
+import (
+	"testing"
+
+	"github.com/powerman/check"
+)
+
+func TestYAMLEqualNotEnabled(tt *testing.T) {
+	tt.Parallel()
+	t := check.T(tt)
+	t.PanicMatch(func() { t.YAMLEqual(`a: 1`, `a: 1`) }, "import github.com/powerman/check/checkyaml")
+}